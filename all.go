@@ -18,7 +18,7 @@ package behaviortree
 
 // All implements a tick which will tick all children sequentially until the first running status or error is
 // encountered (propagated), and will return success only if all children were ticked and returned success (returns
-// success if there were no children, like sequence).
+// success if there were no children, like sequence). Children that are skipped aren't halted, see HaltPreempted.
 func All(children []Node) (Status, error) {
 	success := true
 	for _, child := range children {
@@ -29,7 +29,7 @@ func Any(tick Tick) Tick {
 		mutex   sync.Mutex
 		success bool
 	)
-	return func(children []Node) (Status, error) {
+	return Tick(func(children []Node) (Status, error) {
 		children = copyNodes(children)
 		for i := range children {
 			child := children[i]
@@ -66,7 +66,12 @@ func Any(tick Tick) Tick {
 		}
 		success = false
 		return Success, nil
-	}
+	}).WithHalt(func(children []Node) {
+		mutex.Lock()
+		success = false
+		mutex.Unlock()
+		tick.Halt(children)
+	})
 }
 
 func copyNodes(src []Node) (dst []Node) {
@@ -16,41 +16,90 @@
 
 package behaviortree
 
-// Async wraps a tick so that it runs asynchronously, note nil ticks will return nil
+import (
+	"context"
+	"errors"
+)
+
+type (
+	// asyncTick implements Async and AsyncContext
+	asyncTick struct {
+		// ctx is the parent context, or nil, if fn doesn't accept one
+		ctx context.Context
+		fn  func(ctx context.Context, children []Node) (Status, error)
+		// done is non-nil while running
+		done   chan asyncResult
+		cancel context.CancelFunc
+	}
+
+	asyncResult struct {
+		Status Status
+		Error  error
+	}
+)
+
+// Async wraps a tick so that it runs asynchronously, note nil ticks will return nil. Halting the node (see
+// Node.Halt) will discard any pending result, such that the next tick will start again, though the tick itself can't
+// be interrupted, see AsyncContext.
 func Async(tick Tick) Tick {
 	if tick == nil {
 		return nil
 	}
-	var done chan struct {
-		Status Status
-		Error  error
+	x := &asyncTick{fn: func(_ context.Context, children []Node) (Status, error) { return tick(children) }}
+	return Tick(func(children []Node) (Status, error) { return x.run(children) }).WithHalt(x.halt)
+}
+
+// AsyncContext is like Async, except that fn is passed a context, derived from ctx, which will be canceled if the node
+// is halted (see Node.Halt), or after fn returns. Nil will be returned if fn is nil, and a panic will occur if ctx is
+// nil.
+func AsyncContext(ctx context.Context, fn func(ctx context.Context, children []Node) (Status, error)) Tick {
+	if ctx == nil {
+		panic(errors.New(`behaviortree.AsyncContext nil ctx`))
 	}
-	return func(children []Node) (Status, error) {
-		if done == nil {
-			// start the async tick, the non-nil done indicates that we are running
-			done = make(chan struct {
-				Status Status
-				Error  error
-			}, 1)
-			go func() {
-				var status struct {
-					Status Status
-					Error  error
-				}
-				defer func() {
-					done <- status
-				}()
-				status.Status, status.Error = tick(children)
-			}()
-			return Running, nil
-		}
-		// the node is currently running
-		select {
-		case status := <-done:
-			done = nil
-			return status.Status, status.Error
-		default:
-			return Running, nil
+	if fn == nil {
+		return nil
+	}
+	x := &asyncTick{ctx: ctx, fn: fn}
+	return Tick(func(children []Node) (Status, error) { return x.run(children) }).WithHalt(x.halt)
+}
+
+func (x *asyncTick) run(children []Node) (Status, error) {
+	if x.done == nil {
+		// start the async tick, the non-nil done indicates that we are running
+		var (
+			done   = make(chan asyncResult, 1)
+			ctx    = x.ctx
+			cancel = context.CancelFunc(func() {})
+		)
+		if ctx != nil {
+			ctx, cancel = context.WithCancel(ctx)
 		}
+		x.done, x.cancel = done, cancel
+		go func() {
+			var status asyncResult
+			defer func() {
+				cancel()
+				done <- status
+			}()
+			status.Status, status.Error = x.fn(ctx, children)
+		}()
+		return Running, nil
+	}
+	// the node is currently running
+	select {
+	case status := <-x.done:
+		x.done, x.cancel = nil, nil
+		return status.Status, status.Error
+	default:
+		return Running, nil
+	}
+}
+
+func (x *asyncTick) halt([]Node) {
+	if x.done == nil {
+		return
 	}
+	// the (buffered) channel is discarded, along with any result
+	x.cancel()
+	x.done, x.cancel = nil, nil
 }
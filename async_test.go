@@ -17,6 +17,7 @@
 package behaviortree
 
 import (
+	"context"
 	"errors"
 	"reflect"
 	"testing"
@@ -129,3 +130,85 @@ func TestAsync_nil(t *testing.T) {
 		t.Fatal("expected nil tick")
 	}
 }
+
+func TestAsync_haltDiscards(t *testing.T) {
+	var (
+		release = make(chan struct{})
+		calls   = make(chan int, 2)
+		count   int
+	)
+	node := New(Async(func([]Node) (Status, error) {
+		count++
+		calls <- count
+		<-release
+		return Success, nil
+	}))
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	<-calls
+	node.Halt()
+	close(release)
+	// the next tick starts again, rather than returning the discarded result
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if v := <-calls; v != 2 {
+		t.Error(v)
+	}
+}
+
+func TestAsyncContext_haltCancels(t *testing.T) {
+	started := make(chan context.Context, 1)
+	node := New(AsyncContext(context.Background(), func(ctx context.Context, children []Node) (Status, error) {
+		started <- ctx
+		<-ctx.Done()
+		return Failure, ctx.Err()
+	}))
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	ctx := <-started
+	if err := ctx.Err(); err != nil {
+		t.Fatal(err)
+	}
+	node.Halt()
+	<-ctx.Done()
+	node.Halt()
+}
+
+func TestAsyncContext_cancelOnReturn(t *testing.T) {
+	var ctx context.Context
+	node := New(AsyncContext(context.Background(), func(c context.Context, children []Node) (Status, error) {
+		ctx = c
+		return Success, nil
+	}))
+	status, err := node.Tick()
+	for err == nil && status == Running {
+		time.Sleep(time.Millisecond)
+		status, err = node.Tick()
+	}
+	if err != nil || status != Success {
+		t.Fatal(status, err)
+	}
+	if ctx.Err() == nil {
+		t.Error(`expected canceled`)
+	}
+	tick := AsyncContext(context.Background(), func(context.Context, []Node) (Status, error) { return Success, nil })
+	if kind := tick.Kind(); kind != `AsyncContext` {
+		t.Error(kind)
+	}
+}
+
+func TestAsyncContext_nil(t *testing.T) {
+	if AsyncContext(context.Background(), nil) != nil {
+		t.Error(`expected nil`)
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error(`expected panic`)
+		}
+	}()
+	//lint:ignore SA1012 testing the nil case
+	AsyncContext(nil, func(context.Context, []Node) (Status, error) { return Success, nil })
+}
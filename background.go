@@ -22,14 +22,14 @@ package behaviortree
 // returned, which will trigger removal from the backgrounded node list, and propagating status and any error, without
 // modification. All other normal operation will result in a new node being generated and ticked, backgrounding it on
 // running, otherwise discarding the node and propagating it's return values immediately. Passing a nil value will
-// cause nil to be returned.
+// cause nil to be returned. Halting the node (see Node.Halt) will halt, and discard, all backgrounded nodes.
 // WARNING there is no upper bound to the number of backgrounded nodes (the caller must manage that externally).
 func Background(tick func() Tick) Tick {
 	if tick == nil {
 		return nil
 	}
 	var nodes []Node
-	return func(children []Node) (Status, error) {
+	return Tick(func(children []Node) (Status, error) {
 		for i, node := range nodes {
 			status, err := node.Tick()
 			if err == nil && status == Running {
@@ -47,5 +47,9 @@ func Background(tick func() Tick) Tick {
 		}
 		nodes = append(nodes, node)
 		return Running, nil
-	}
+	}).WithHalt(func([]Node) {
+		halt := nodes
+		nodes = nil
+		haltNodes(halt)
+	})
 }
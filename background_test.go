@@ -19,6 +19,7 @@ package behaviortree
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -267,3 +268,26 @@ func TestBackground_withAny(t *testing.T) {
 		t.Error(status, err)
 	}
 }
+
+func TestBackground_halt(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`a`: Running}}
+	node := New(Background(func() Tick { return Sequence }), r.node(`a`))
+	for i := 0; i < 2; i++ {
+		if status, err := node.Tick(); err != nil || status != Running {
+			t.Fatal(status, err)
+		}
+	}
+	r.take()
+	node.Halt()
+	if v := r.take(); !reflect.DeepEqual(v, []string{`halt a`, `halt a`}) {
+		t.Error(v)
+	}
+	// the backgrounded nodes were discarded
+	r.statuses[`a`] = Success
+	if status, err := node.Tick(); err != nil || status != Success {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`}) {
+		t.Error(v)
+	}
+}
@@ -0,0 +1,192 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"errors"
+	"maps"
+	"sync"
+)
+
+type (
+	// Blackboard is a concurrency-safe key-value store, for sharing state between ticks, which may be split into
+	// scopes, e.g. for subtrees, see Blackboard.Scope. Values are accessed using typed keys, see Key.
+	//
+	// A blackboard may be attached to a node, using Node.WithBlackboard, for access via GetBlackboard, or registered
+	// with UseValueProvider, which it implements.
+	Blackboard struct {
+		mu     sync.RWMutex
+		values map[string]any
+		parent *Blackboard
+		remap  map[string]string
+	}
+
+	// Key is a typed key for a value stored in a Blackboard, identified by name, see NewKey
+	Key[T any] struct {
+		name string
+	}
+
+	// vkBlackboard is the context key for GetBlackboard
+	vkBlackboard struct{}
+)
+
+// NewBlackboard constructs a new, empty, Blackboard.
+func NewBlackboard() *Blackboard {
+	return new(Blackboard)
+}
+
+// Scope returns a new child scope of the receiver, where keys present in remap (as keys) are mapped to the
+// corresponding keys of the receiver (like ports of a subtree), and all other keys are local to the child scope.
+func (b *Blackboard) Scope(remap map[string]string) *Blackboard {
+	if b == nil {
+		panic(errors.New(`behaviortree.Blackboard.Scope nil receiver`))
+	}
+	return &Blackboard{parent: b, remap: maps.Clone(remap)}
+}
+
+// Parent returns the blackboard the receiver was scoped from, or nil.
+func (b *Blackboard) Parent() *Blackboard {
+	return b.parent
+}
+
+// Range calls fn for each value stored directly in the receiver, i.e. not including any remapped keys, in no
+// particular order, until fn returns false. The receiver must not be modified by fn.
+func (b *Blackboard) Range(fn func(name string, value any) bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for k, v := range b.values {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+// Value implements ValueProvider, providing the receiver for GetBlackboard
+func (b *Blackboard) Value(key any) (any, bool) {
+	if key == (vkBlackboard{}) {
+		if b == nil {
+			return nil, true
+		}
+		return b, true
+	}
+	return nil, false
+}
+
+// resolve follows any remapping of name, returning the blackboard and name that actually store the value
+func (b *Blackboard) resolve(name string) (*Blackboard, string) {
+	for b.parent != nil {
+		mapped, ok := b.remap[name]
+		if !ok {
+			break
+		}
+		b, name = b.parent, mapped
+	}
+	return b, name
+}
+
+func (b *Blackboard) load(name string) (any, bool) {
+	b, name = b.resolve(name)
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	v, ok := b.values[name]
+	return v, ok
+}
+
+func (b *Blackboard) store(name string, value any) {
+	b, name = b.resolve(name)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.values == nil {
+		b.values = make(map[string]any)
+	}
+	b.values[name] = value
+}
+
+func (b *Blackboard) delete(name string) {
+	b, name = b.resolve(name)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.values, name)
+}
+
+// NewKey constructs a new Key, note that keys with the same name share the same value, regardless of type.
+func NewKey[T any](name string) Key[T] {
+	return Key[T]{name: name}
+}
+
+// Name returns the name of the key.
+func (k Key[T]) Name() string {
+	return k.name
+}
+
+// Get returns the value for the key, and true, or the zero value and false, if the value is not present or is of
+// another type, or the blackboard is nil.
+func (k Key[T]) Get(b *Blackboard) (value T, ok bool) {
+	if b != nil {
+		var v any
+		if v, ok = b.load(k.name); ok {
+			value, ok = v.(T)
+		}
+	}
+	return
+}
+
+// Set stores the value for the key, and will panic if the blackboard is nil.
+func (k Key[T]) Set(b *Blackboard, value T) {
+	if b == nil {
+		panic(errors.New(`behaviortree.Key.Set nil blackboard`))
+	}
+	b.store(k.name, value)
+}
+
+// Delete removes any value for the key, and is a noop if the blackboard is nil.
+func (k Key[T]) Delete(b *Blackboard) {
+	if b != nil {
+		b.delete(k.name)
+	}
+}
+
+// GetBlackboard retrieves the attached blackboard from the Valuer, or nil if not present.
+//
+// This helper facilitates interoperability with external implementations of the [Valuer] interface.
+func GetBlackboard(n Valuer) *Blackboard {
+	v, _ := n.Value(vkBlackboard{}).(*Blackboard)
+	return v
+}
+
+// WithBlackboard returns the value attachable with the blackboard attached.
+//
+// Passing a nil blackboard will attach a nil value, effectively clearing any previous blackboard.
+//
+// This helper facilitates interoperability with external implementations of the [ValueAttachable] interface.
+func WithBlackboard[T any](n ValueAttachable[T], b *Blackboard) T {
+	if b == nil {
+		return n.WithValue(vkBlackboard{}, nil)
+	}
+	return n.WithValue(vkBlackboard{}, b)
+}
+
+// WithBlackboard returns a copy of the receiver, wrapped with the blackboard attached, for access via
+// Node.Blackboard.
+func (n Node) WithBlackboard(b *Blackboard) Node {
+	return WithBlackboard[Node](n, b)
+}
+
+// Blackboard returns the blackboard attached to the node, or nil.
+func (n Node) Blackboard() *Blackboard {
+	return GetBlackboard(n)
+}
@@ -0,0 +1,196 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestKey_getSetDelete(t *testing.T) {
+	b := NewBlackboard()
+	count := NewKey[int](`count`)
+	if count.Name() != `count` {
+		t.Error(count.Name())
+	}
+	if v, ok := count.Get(b); v != 0 || ok {
+		t.Error(v, ok)
+	}
+	count.Set(b, 3)
+	if v, ok := count.Get(b); v != 3 || !ok {
+		t.Error(v, ok)
+	}
+	if v, ok := NewKey[string](`count`).Get(b); v != `` || ok {
+		t.Error(v, ok)
+	}
+	count.Delete(b)
+	if v, ok := count.Get(b); v != 0 || ok {
+		t.Error(v, ok)
+	}
+	if v, ok := count.Get(nil); v != 0 || ok {
+		t.Error(v, ok)
+	}
+	count.Delete(nil)
+}
+
+func TestKey_Set_nilBlackboard(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil || r.(error).Error() != `behaviortree.Key.Set nil blackboard` {
+			t.Error(r)
+		}
+	}()
+	NewKey[int](`a`).Set(nil, 1)
+	t.Error(`expected panic`)
+}
+
+func TestBlackboard_Scope(t *testing.T) {
+	var (
+		root   = NewBlackboard()
+		target = NewKey[string](`target`)
+		goal   = NewKey[string](`goal`)
+		local  = NewKey[int](`local`)
+	)
+	target.Set(root, `a`)
+	local.Set(root, 1)
+	child := root.Scope(map[string]string{`goal`: `target`})
+	if child.Parent() != root || root.Parent() != nil {
+		t.Error(child.Parent(), root.Parent())
+	}
+	if v, ok := goal.Get(child); v != `a` || !ok {
+		t.Error(v, ok)
+	}
+	if v, ok := local.Get(child); v != 0 || ok {
+		t.Error(v, ok)
+	}
+	goal.Set(child, `b`)
+	local.Set(child, 2)
+	if v, _ := target.Get(root); v != `b` {
+		t.Error(v)
+	}
+	if v, _ := local.Get(root); v != 1 {
+		t.Error(v)
+	}
+	grandchild := child.Scope(map[string]string{`port`: `goal`})
+	NewKey[string](`port`).Set(grandchild, `c`)
+	if v, _ := target.Get(root); v != `c` {
+		t.Error(v)
+	}
+	goal.Delete(child)
+	if _, ok := target.Get(root); ok {
+		t.Error(`expected deleted`)
+	}
+	names := make(map[string]any)
+	child.Range(func(name string, value any) bool {
+		names[name] = value
+		return true
+	})
+	if len(names) != 1 || names[`local`] != 2 {
+		t.Error(names)
+	}
+}
+
+func TestBlackboard_Scope_nilReceiver(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil || r.(error).Error() != `behaviortree.Blackboard.Scope nil receiver` {
+			t.Error(r)
+		}
+	}()
+	(*Blackboard)(nil).Scope(nil)
+	t.Error(`expected panic`)
+}
+
+func TestBlackboard_Range_stop(t *testing.T) {
+	b := NewBlackboard()
+	NewKey[int](`a`).Set(b, 1)
+	NewKey[int](`b`).Set(b, 2)
+	var count int
+	b.Range(func(string, any) bool {
+		count++
+		return false
+	})
+	if count != 1 {
+		t.Error(count)
+	}
+}
+
+func TestNode_WithBlackboard(t *testing.T) {
+	b := NewBlackboard()
+	node := New(Sequence)
+	if v := node.Blackboard(); v != nil {
+		t.Error(v)
+	}
+	node = node.WithBlackboard(b)
+	if v := node.Blackboard(); v != b {
+		t.Error(v)
+	}
+	if v := node.WithBlackboard(nil).Blackboard(); v != nil {
+		t.Error(v)
+	}
+}
+
+func TestBlackboard_useValueProvider(t *testing.T) {
+	b := NewBlackboard()
+	key := NewKey[int](`ticks`)
+	node := Node(func() (Tick, []Node) {
+		UseValueProvider(b)
+		return func([]Node) (Status, error) {
+			v, _ := key.Get(b)
+			key.Set(b, v+1)
+			return Success, nil
+		}, nil
+	})
+	if v := GetBlackboard(node); v != b {
+		t.Fatal(v)
+	}
+	for i := 0; i < 2; i++ {
+		if status, err := node.Tick(); err != nil || status != Success {
+			t.Fatal(status, err)
+		}
+	}
+	if v, _ := key.Get(GetBlackboard(node)); v != 2 {
+		t.Error(v)
+	}
+	if v, ok := (*Blackboard)(nil).Value(vkBlackboard{}); v != nil || !ok {
+		t.Error(v, ok)
+	}
+	if v, ok := b.Value(vkName{}); v != nil || ok {
+		t.Error(v, ok)
+	}
+}
+
+func TestBlackboard_concurrent(t *testing.T) {
+	var (
+		b     = NewBlackboard()
+		child = b.Scope(map[string]string{`x`: `x`})
+		key   = NewKey[int](`x`)
+		wg    sync.WaitGroup
+	)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				key.Set(child, i)
+				key.Get(b)
+			}
+		}(i)
+	}
+	wg.Wait()
+	if _, ok := key.Get(b); !ok {
+		t.Error(`expected value`)
+	}
+}
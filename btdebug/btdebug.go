@@ -0,0 +1,268 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package btdebug provides a live debugger for behavior trees, built using the behaviortree package, served as a
+// local web page, which shows the latest status of each node, and may be used to pause and step ticking.
+//
+// Example usage:
+//
+//	d := btdebug.New(node)
+//	go http.ListenAndServe(`localhost:8080`, d)
+//	ticker := bt.NewTicker(ctx, time.Millisecond*100, d.Node())
+//	defer func() {
+//		// a paused tick can't be interrupted, so the debugger must be closed before the ticker will stop
+//		d.Close()
+//		ticker.Stop()
+//		<-ticker.Done()
+//	}()
+package btdebug
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+	"github.com/joeycumines/go-behaviortree/btrecord"
+	"github.com/joeycumines/go-behaviortree/internal/bttrace"
+)
+
+type (
+	// Debugger records the status of each node of a tree, as it's ticked via Debugger.Node, and implements
+	// http.Handler, to serve a web page for inspection and control, see Debugger.ServeHTTP.
+	Debugger struct {
+		root        bt.Node
+		node        bt.Node
+		mutex       sync.Mutex
+		cond        *sync.Cond
+		tick        int
+		paused      bool
+		steps       int
+		closed      bool
+		states      map[string]NodeState
+		subscribers map[chan []byte]struct{}
+	}
+
+	// NodeState is the latest recorded state of a node, see Debugger.Snapshot
+	NodeState struct {
+		// Tick is the (root) tick number at which the node was last ticked, starting from 1
+		Tick int `json:"tick"`
+		// Status is the status last returned by the node
+		Status string `json:"status"`
+		// Error is the error last returned by the node, if any
+		Error string `json:"error,omitempty"`
+		// Elapsed is the time the node took to tick
+		Elapsed time.Duration `json:"elapsed"`
+	}
+
+	// Snapshot models the state of a Debugger, as sent to the web page
+	Snapshot struct {
+		// Tick is the number of (root) ticks that have completed
+		Tick int `json:"tick"`
+		// Paused indicates if ticking is paused
+		Paused bool `json:"paused"`
+		// States contains the latest state of each node that has been ticked, keyed by ID, see Tree
+		States map[string]NodeState `json:"states"`
+	}
+
+	// Option configures the behavior of New, see WithClock
+	Option = bttrace.Option
+
+	// Tree models the structure of the tree, as sent to the web page, see Debugger.Tree
+	Tree = btrecord.Tree
+)
+
+// New constructs a new Debugger for the given node, which must be ticked using Debugger.Node, note that a panic will
+// occur if node is nil.
+func New(node bt.Node, options ...Option) *Debugger {
+	if node == nil {
+		panic(errors.New(`btdebug.New nil node`))
+	}
+	c := bttrace.NewConfig(options)
+	d := &Debugger{
+		root:        node,
+		states:      make(map[string]NodeState),
+		subscribers: make(map[chan []byte]struct{}),
+	}
+	d.cond = sync.NewCond(&d.mutex)
+	d.node = bt.Trace(node, bttrace.Tracer{Start: d.start, End: d.record}, bt.WithClock(c.Clock))
+	return d
+}
+
+// WithClock configures the clock used to measure the time each node took to tick, see bt.WithClock.
+func WithClock(clock bt.Clock) Option {
+	return bttrace.WithClock(clock)
+}
+
+// Node returns the instrumented node, which should be ticked (e.g. by a bt.Ticker) in place of the original. Each
+// tick of the returned node will block while the Debugger is paused, see Debugger.Pause.
+//
+// A paused tick can't be interrupted, i.e. it doesn't observe the stopping of a bt.Ticker or bt.Manager, or the
+// cancellation of any context, meaning these will block until the Debugger is resumed. Debugger.Close must therefore
+// be called before (or while) stopping whatever is ticking the node.
+func (d *Debugger) Node() bt.Node {
+	return d.node
+}
+
+// Pause causes subsequent ticks to block until Debugger.Resume, Debugger.Step, or Debugger.Close is called, see also
+// Debugger.Node.
+func (d *Debugger) Pause() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if !d.closed && !d.paused {
+		d.paused = true
+		d.publish()
+	}
+}
+
+// Resume undoes Debugger.Pause.
+func (d *Debugger) Resume() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.paused {
+		d.paused = false
+		d.steps = 0
+		d.cond.Broadcast()
+		d.publish()
+	}
+}
+
+// Step allows a single tick to proceed, while paused, and is otherwise a noop.
+func (d *Debugger) Step() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.paused {
+		d.steps++
+		d.cond.Broadcast()
+	}
+}
+
+// Close resumes ticking (permanently), and disconnects any web page, though the Debugger may still be used to record
+// state, e.g. via Debugger.Snapshot.
+func (d *Debugger) Close() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.closed {
+		return
+	}
+	d.closed = true
+	d.paused = false
+	d.cond.Broadcast()
+	for ch := range d.subscribers {
+		close(ch)
+		delete(d.subscribers, ch)
+	}
+}
+
+// Snapshot returns the current state of the Debugger.
+func (d *Debugger) Snapshot() Snapshot {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.snapshot()
+}
+
+// Tree returns the structure of the tree, as it would be ticked, see btrecord.NewTree, the IDs of which correspond to
+// the States of each Snapshot.
+func (d *Debugger) Tree() *Tree {
+	return btrecord.NewTree(d.root)
+}
+
+// start implements bttrace.Tracer.Start, gating ticks of the root
+func (d *Debugger) start(id string) {
+	if id == bttrace.RootID {
+		d.wait()
+	}
+}
+
+// wait blocks while paused, unless a step is available
+func (d *Debugger) wait() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	for d.paused && d.steps == 0 {
+		d.cond.Wait()
+	}
+	if d.steps > 0 {
+		d.steps--
+	}
+	d.tick++
+}
+
+// record implements bttrace.Tracer.End, recording the state of the node, and publishing after each tick of the root
+func (d *Debugger) record(id string, status bt.Status, err error, elapsed time.Duration) {
+	state := NodeState{Status: status.String(), Elapsed: elapsed}
+	if err != nil {
+		state.Error = err.Error()
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	state.Tick = d.tick
+	d.states[id] = state
+	if id == bttrace.RootID {
+		d.publish()
+	}
+}
+
+func (d *Debugger) snapshot() Snapshot {
+	states := make(map[string]NodeState, len(d.states))
+	for k, v := range d.states {
+		states[k] = v
+	}
+	return Snapshot{Tick: d.tick, Paused: d.paused, States: states}
+}
+
+// publish sends the current snapshot to each subscriber, replacing any unsent snapshot, must be called with the
+// mutex held
+func (d *Debugger) publish() {
+	if len(d.subscribers) == 0 {
+		return
+	}
+	b, err := json.Marshal(d.snapshot())
+	if err != nil {
+		return
+	}
+	for ch := range d.subscribers {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- b
+	}
+}
+
+// subscribe registers a channel to receive snapshots, returning nil if closed
+func (d *Debugger) subscribe() chan []byte {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.closed {
+		return nil
+	}
+	ch := make(chan []byte, 1)
+	if b, err := json.Marshal(d.snapshot()); err == nil {
+		ch <- b
+	}
+	d.subscribers[ch] = struct{}{}
+	return ch
+}
+
+func (d *Debugger) unsubscribe(ch chan []byte) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if _, ok := d.subscribers[ch]; ok {
+		delete(d.subscribers, ch)
+		close(ch)
+	}
+}
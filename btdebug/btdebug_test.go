@@ -0,0 +1,272 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package btdebug
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+	"github.com/joeycumines/go-behaviortree/bttest"
+)
+
+func newTestTree() bt.Node {
+	return bt.New(
+		bt.Selector,
+		bt.New(func([]bt.Node) (bt.Status, error) { return bt.Failure, errors.New(`some_error`) }).WithName(`a`),
+		bt.New(func([]bt.Node) (bt.Status, error) { return bt.Success, nil }).WithName(`b`),
+	).WithName(`root`)
+}
+
+func TestDebugger_record(t *testing.T) {
+	d := New(newTestTree())
+	defer d.Close()
+	if status, err := d.Node().Tick(); err == nil || status != bt.Failure {
+		t.Fatal(status, err)
+	}
+	s := d.Snapshot()
+	if s.Tick != 1 || s.Paused || len(s.States) != 2 {
+		t.Fatal(s)
+	}
+	if v := s.States[`0`]; v.Tick != 1 || v.Status != `failure` || v.Error != `some_error` {
+		t.Error(v)
+	}
+	if v := s.States[`0.0`]; v.Tick != 1 || v.Status != `failure` || v.Error != `some_error` {
+		t.Error(v)
+	}
+	if v := d.Tree(); !reflect.DeepEqual(v, &Tree{ID: `0`, Name: `root`, Children: []*Tree{
+		{ID: `0.0`, Name: `a`},
+		{ID: `0.1`, Name: `b`},
+	}}) {
+		t.Error(v)
+	}
+}
+
+func TestDebugger_Tree_structure(t *testing.T) {
+	// the logical structure doesn't correspond to the ticked nodes, which are what is recorded
+	d := New(newTestTree().WithStructure(slices.Values([]bt.Metadata{
+		bt.New(bt.Sequence).WithName(`virtualA`),
+	})))
+	defer d.Close()
+	if status, err := d.Node().Tick(); err == nil || status != bt.Failure {
+		t.Fatal(status, err)
+	}
+	if v := d.Tree(); !reflect.DeepEqual(v, &Tree{ID: `0`, Name: `root`, Children: []*Tree{
+		{ID: `0.0`, Name: `a`},
+		{ID: `0.1`, Name: `b`},
+	}}) {
+		t.Error(v)
+	}
+	if v := d.Snapshot().States[`0.0`]; v.Status != `failure` || v.Error != `some_error` {
+		t.Error(v)
+	}
+}
+
+func TestDebugger_clock(t *testing.T) {
+	clock := bttest.NewClock(time.Unix(0, 0))
+	d := New(bt.New(
+		bt.Sequence,
+		bt.New(func([]bt.Node) (bt.Status, error) {
+			clock.Advance(time.Second)
+			return bt.Success, nil
+		}),
+	), WithClock(clock))
+	defer d.Close()
+	if status, err := d.Node().Tick(); err != nil || status != bt.Success {
+		t.Fatal(status, err)
+	}
+	s := d.Snapshot()
+	if v := s.States[`0`]; v.Elapsed != time.Second {
+		t.Error(v)
+	}
+	if v := s.States[`0.0`]; v.Elapsed != time.Second {
+		t.Error(v)
+	}
+}
+
+func TestDebugger_pause(t *testing.T) {
+	d := New(newTestTree())
+	defer d.Close()
+	ticks := make(chan struct{}, 10)
+	go func() {
+		for i := 0; i < 3; i++ {
+			_, _ = d.Node().Tick()
+			ticks <- struct{}{}
+		}
+	}()
+	d.Pause()
+	d.Pause()
+	d.Step()
+	expectTicks := func(n int) {
+		t.Helper()
+		for i := 0; i < n; i++ {
+			select {
+			case <-ticks:
+			case <-time.After(time.Second * 5):
+				t.Fatal(`expected tick`)
+			}
+		}
+		select {
+		case <-ticks:
+			t.Fatal(`unexpected tick`)
+		case <-time.After(time.Millisecond * 50):
+		}
+	}
+	expectTicks(1)
+	if s := d.Snapshot(); !s.Paused || s.Tick != 1 {
+		t.Error(s)
+	}
+	d.Step()
+	expectTicks(1)
+	d.Resume()
+	expectTicks(1)
+	d.Step()
+	if s := d.Snapshot(); s.Paused || s.Tick != 3 {
+		t.Error(s)
+	}
+}
+
+func TestDebugger_Close_stopTicker(t *testing.T) {
+	d := New(bt.New(bt.Sequence))
+	d.Pause()
+	ticker := bt.NewTicker(context.Background(), time.Millisecond, d.Node())
+	time.Sleep(time.Millisecond * 20)
+	go ticker.Stop()
+	select {
+	case <-ticker.Done():
+		t.Fatal(`expected the paused tick to block stopping`)
+	case <-time.After(time.Millisecond * 50):
+	}
+	d.Close()
+	select {
+	case <-ticker.Done():
+	case <-time.After(time.Second * 5):
+		t.Fatal(`expected the ticker to stop`)
+	}
+	if s := d.Snapshot(); s.Paused || s.Tick != 1 {
+		t.Error(s)
+	}
+}
+
+func TestDebugger_ServeHTTP(t *testing.T) {
+	d := New(newTestTree())
+	defer d.Close()
+	server := httptest.NewServer(d)
+	defer server.Close()
+
+	for _, tc := range []struct {
+		Method      string
+		Path        string
+		Status      int
+		ContentType string
+	}{
+		{http.MethodGet, `/`, http.StatusOK, `text/html; charset=utf-8`},
+		{http.MethodGet, `/tree`, http.StatusOK, `application/json`},
+		{http.MethodGet, `/snapshot`, http.StatusOK, `application/json`},
+		{http.MethodPost, `/pause`, http.StatusNoContent, ``},
+		{http.MethodPost, `/step`, http.StatusNoContent, ``},
+		{http.MethodPost, `/resume`, http.StatusNoContent, ``},
+		{http.MethodPost, `/`, http.StatusMethodNotAllowed, `text/plain; charset=utf-8`},
+		{http.MethodGet, `/pause`, http.StatusMethodNotAllowed, `text/plain; charset=utf-8`},
+		{http.MethodGet, `/nope`, http.StatusNotFound, `text/plain; charset=utf-8`},
+	} {
+		t.Run(fmt.Sprint(tc.Method, tc.Path), func(t *testing.T) {
+			req, err := http.NewRequest(tc.Method, server.URL+tc.Path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_ = res.Body.Close()
+			if res.StatusCode != tc.Status || res.Header.Get(`Content-Type`) != tc.ContentType {
+				t.Error(res.StatusCode, res.Header.Get(`Content-Type`))
+			}
+		})
+	}
+}
+
+func TestDebugger_events(t *testing.T) {
+	d := New(newTestTree())
+	server := httptest.NewServer(d)
+	defer server.Close()
+	res, err := http.Get(server.URL + `/events`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if v := res.Header.Get(`Content-Type`); v != `text/event-stream` {
+		t.Fatal(v)
+	}
+	reader := bufio.NewReader(res.Body)
+	next := func() (s Snapshot) {
+		t.Helper()
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				t.Fatal(err)
+			}
+			if data, ok := strings.CutPrefix(line, `data: `); ok {
+				if err := json.Unmarshal([]byte(data), &s); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+		}
+	}
+	if s := next(); s.Tick != 0 {
+		t.Error(s)
+	}
+	_, _ = d.Node().Tick()
+	if s := next(); s.Tick != 1 || s.States[`0`].Error != `some_error` {
+		t.Error(s)
+	}
+	d.Close()
+	if _, err := io.ReadAll(reader); err != nil {
+		t.Error(err)
+	}
+	res, err = http.Get(server.URL + `/events`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = res.Body.Close()
+	if res.StatusCode != http.StatusServiceUnavailable {
+		t.Error(res.StatusCode)
+	}
+}
+
+func TestNew_nil(t *testing.T) {
+	defer func() {
+		if s := fmt.Sprint(recover()); s != `btdebug.New nil node` {
+			t.Error(s)
+		}
+	}()
+	New(nil)
+	t.Error(`expected a panic`)
+}
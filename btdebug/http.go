@@ -0,0 +1,180 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package btdebug
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// ServeHTTP implements http.Handler, serving the following endpoints, relative to the handler's root (see
+// http.StripPrefix):
+//
+//   - GET / serves the web page
+//   - GET /tree serves the Tree as JSON
+//   - GET /snapshot serves the current Snapshot as JSON
+//   - GET /events streams each Snapshot, after each tick, as server-sent events
+//   - POST /pause, /resume, and /step control ticking, see Debugger.Pause, Debugger.Resume, and Debugger.Step
+func (d *Debugger) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case `/`, ``:
+		if !allowMethod(w, r, http.MethodGet) {
+			return
+		}
+		w.Header().Set(`Content-Type`, `text/html; charset=utf-8`)
+		_, _ = io.WriteString(w, page)
+	case `/tree`:
+		if allowMethod(w, r, http.MethodGet) {
+			writeJSON(w, d.Tree())
+		}
+	case `/snapshot`:
+		if allowMethod(w, r, http.MethodGet) {
+			writeJSON(w, d.Snapshot())
+		}
+	case `/events`:
+		if allowMethod(w, r, http.MethodGet) {
+			d.serveEvents(w, r)
+		}
+	case `/pause`, `/resume`, `/step`:
+		if !allowMethod(w, r, http.MethodPost) {
+			return
+		}
+		switch r.URL.Path {
+		case `/pause`:
+			d.Pause()
+		case `/resume`:
+			d.Resume()
+		case `/step`:
+			d.Step()
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (d *Debugger) serveEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, `streaming unsupported`, http.StatusInternalServerError)
+		return
+	}
+	ch := d.subscribe()
+	if ch == nil {
+		http.Error(w, `debugger closed`, http.StatusServiceUnavailable)
+		return
+	}
+	defer d.unsubscribe(ch)
+	w.Header().Set(`Content-Type`, `text/event-stream`)
+	w.Header().Set(`Cache-Control`, `no-cache`)
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case b, ok := <-ch:
+			if !ok {
+				return
+			}
+			if _, err := w.Write(append(append([]byte(`data: `), b...), '\n', '\n')); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func allowMethod(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method != method {
+		w.Header().Set(`Allow`, method)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set(`Content-Type`, `application/json`)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+const page = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>behaviortree debugger</title>
+<style>
+body { font-family: monospace; margin: 1em; }
+ul { list-style: none; padding-left: 1.5em; border-left: 1px dotted #ccc; }
+span.node { padding: 0 0.3em; border-radius: 3px; }
+.running { background: gold; }
+.success { background: palegreen; }
+.failure { background: salmon; }
+.stale { opacity: 0.4; }
+.error { color: darkred; }
+</style>
+</head>
+<body>
+<div>
+<button onclick="post('pause')">pause</button>
+<button onclick="post('resume')">resume</button>
+<button onclick="post('step')">step</button>
+<span id="state"></span>
+</div>
+<div id="tree"></div>
+<script>
+function post(action) { fetch(action, {method: 'POST'}); }
+function render(node) {
+  const li = document.createElement('li');
+  const span = document.createElement('span');
+  span.className = 'node';
+  span.id = 'node-' + node.id;
+  span.textContent = node.name;
+  li.appendChild(span);
+  const info = document.createElement('span');
+  info.id = 'info-' + node.id;
+  li.appendChild(info);
+  if (node.children) {
+    const ul = document.createElement('ul');
+    node.children.forEach(child => ul.appendChild(render(child)));
+    li.appendChild(ul);
+  }
+  return li;
+}
+function update(snapshot) {
+  document.getElementById('state').textContent = 'tick ' + snapshot.tick + (snapshot.paused ? ' (paused)' : '');
+  for (const [id, state] of Object.entries(snapshot.states)) {
+    const span = document.getElementById('node-' + id);
+    const info = document.getElementById('info-' + id);
+    if (!span) continue;
+    span.className = 'node ' + state.status + (state.tick < snapshot.tick ? ' stale' : '');
+    info.className = state.error ? 'error' : '';
+    info.textContent = ' ' + (state.elapsed / 1e6).toFixed(3) + 'ms' + (state.error ? ' ' + state.error : '');
+  }
+}
+fetch('tree').then(r => r.json()).then(tree => {
+  const ul = document.createElement('ul');
+  ul.appendChild(render(tree));
+  document.getElementById('tree').appendChild(ul);
+  new EventSource('events').onmessage = e => update(JSON.parse(e.data));
+});
+</script>
+</body>
+</html>
+`
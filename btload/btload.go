@@ -0,0 +1,301 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package btload provides declarative loading of behavior trees, built using the behaviortree package, from YAML or
+// JSON documents, using a Registry of named tick factories.
+//
+// Each node in a document is an object with a "type" (the registered name), and optionally a "name", "params" (an
+// object, decoded into the factory's parameters, using encoding/json), and "children" (an array of nodes), e.g.
+//
+//	type: Selector
+//	children:
+//	  - type: Memorize
+//	    children:
+//	      - type: Sequence
+//	        children:
+//	          - type: Refill
+//	            params: {amount: 3}
+//	  - type: RateLimit
+//	    params: {duration: 1s}
+package btload
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	bt "github.com/joeycumines/go-behaviortree"
+	"go.yaml.in/yaml/v3"
+)
+
+type (
+	// Registry maps names to tick factories, and may be used to load trees, see Registry.Load. It is safe for
+	// concurrent use.
+	Registry struct {
+		mutex     sync.RWMutex
+		factories map[string]factory
+	}
+
+	// factory builds the tick and children of a node, from it's params and children
+	factory func(decode func(v any) error, children []bt.Node) (bt.Tick, []bt.Node, error)
+)
+
+// NewRegistry constructs a new Registry, with the following built-in types registered:
+//
+//   - Composites (with parameters, if any): Sequence, Selector, ReactiveSequence, ReactiveSelector,
+//     SequenceWithMemory, SelectorWithMemory, All, Switch, Fork, Parallel (success, failure)
+//   - Leaves: RateLimit (duration)
+//   - Decorators (with parameters, if any): Memorize, Async, Not, Any, Shuffle, RepeatN (n), RepeatUntilFailure,
+//     RepeatUntilSuccess, ForceSuccess, ForceFailure, Timeout (duration), Cooldown (duration), Debounce (duration)
+//
+// Decorators (see RegisterDecorator) must have exactly one child, the tick of which is wrapped, and the children of
+// which become the children of the decorator, e.g. a Memorize with a Sequence child is equivalent to
+// bt.New(bt.Memorize(bt.Sequence), ...).
+func NewRegistry() *Registry {
+	r := new(Registry)
+	for name, tick := range map[string]bt.Tick{
+		`Sequence`: bt.Sequence,
+		`Selector`: bt.Selector,
+		`All`:      bt.All,
+		`Switch`:   bt.Switch,
+	} {
+		Register(r, name, func(struct{}) (bt.Tick, error) { return tick, nil })
+	}
+	for name, tick := range map[string]func() bt.Tick{
+		`Fork`:               bt.Fork,
+		`ReactiveSequence`:   bt.ReactiveSequence,
+		`ReactiveSelector`:   bt.ReactiveSelector,
+		`SequenceWithMemory`: bt.SequenceWithMemory,
+		`SelectorWithMemory`: bt.SelectorWithMemory,
+	} {
+		Register(r, name, func(struct{}) (bt.Tick, error) { return tick(), nil })
+	}
+	Register(r, `Parallel`, func(p struct {
+		Success int `json:"success"`
+		Failure int `json:"failure"`
+	}) (bt.Tick, error) {
+		if p.Success == 0 || p.Failure == 0 {
+			return nil, errors.New(`success and failure thresholds are required`)
+		}
+		return bt.Parallel(p.Success, p.Failure), nil
+	})
+	Register(r, `RateLimit`, func(p struct {
+		Duration Duration `json:"duration"`
+	}) (bt.Tick, error) {
+		return bt.RateLimit(p.Duration.Duration()), nil
+	})
+	for name, decorator := range map[string]func(bt.Tick) bt.Tick{
+		`Memorize`:           bt.Memorize,
+		`Async`:              bt.Async,
+		`Not`:                bt.Not,
+		`Any`:                bt.Any,
+		`Shuffle`:            func(tick bt.Tick) bt.Tick { return bt.Shuffle(tick, nil) },
+		`RepeatUntilFailure`: bt.RepeatUntilFailure,
+		`RepeatUntilSuccess`: bt.RepeatUntilSuccess,
+		`ForceSuccess`:       bt.ForceSuccess,
+		`ForceFailure`:       bt.ForceFailure,
+	} {
+		RegisterDecorator(r, name, func(_ struct{}, tick bt.Tick) (bt.Tick, error) { return decorator(tick), nil })
+	}
+	RegisterDecorator(r, `RepeatN`, func(p struct {
+		N int `json:"n"`
+	}, tick bt.Tick) (bt.Tick, error) {
+		return bt.RepeatN(p.N, tick), nil
+	})
+	RegisterDecorator(r, `Timeout`, func(p struct {
+		Duration Duration `json:"duration"`
+	}, tick bt.Tick) (bt.Tick, error) {
+		if p.Duration <= 0 {
+			return nil, errors.New(`duration must be positive`)
+		}
+		return bt.Timeout(p.Duration.Duration(), tick), nil
+	})
+	RegisterDecorator(r, `Cooldown`, func(p struct {
+		Duration Duration `json:"duration"`
+	}, tick bt.Tick) (bt.Tick, error) {
+		return bt.Cooldown(p.Duration.Duration(), tick), nil
+	})
+	RegisterDecorator(r, `Debounce`, func(p struct {
+		Duration Duration `json:"duration"`
+	}, tick bt.Tick) (bt.Tick, error) {
+		return bt.Debounce(p.Duration.Duration(), tick), nil
+	})
+	return r
+}
+
+// Register registers a factory for nodes of the given type name, which will be called with the node's params, to
+// build a tick, that will be used with the node's children. Params are decoded into P using encoding/json, and
+// unknown fields are not allowed, meaning struct{} may be used for types without any. A panic will occur if r or fn
+// are nil, or if the name is empty or already registered.
+func Register[P any](r *Registry, name string, fn func(params P) (bt.Tick, error)) {
+	if fn == nil {
+		panic(errors.New(`btload.Register nil factory`))
+	}
+	r.register(`btload.Register`, name, func(decode func(v any) error, children []bt.Node) (bt.Tick, []bt.Node, error) {
+		var params P
+		if err := decode(&params); err != nil {
+			return nil, nil, err
+		}
+		tick, err := fn(params)
+		return tick, children, err
+	})
+}
+
+// RegisterDecorator registers a factory for decorator nodes of the given type name, which must have exactly one
+// child, the tick of which will be wrapped by fn (see NewRegistry), with the params decoded as per Register. A panic
+// will occur if r or fn are nil, or if the name is empty or already registered.
+func RegisterDecorator[P any](r *Registry, name string, fn func(params P, tick bt.Tick) (bt.Tick, error)) {
+	if fn == nil {
+		panic(errors.New(`btload.RegisterDecorator nil factory`))
+	}
+	r.register(`btload.RegisterDecorator`, name, func(decode func(v any) error, children []bt.Node) (bt.Tick, []bt.Node, error) {
+		if len(children) != 1 || children[0] == nil {
+			return nil, nil, errors.New(`decorator must have exactly one child`)
+		}
+		var params P
+		if err := decode(&params); err != nil {
+			return nil, nil, err
+		}
+		tick, children := children[0]()
+		tick, err := fn(params, tick)
+		return tick, children, err
+	})
+}
+
+func (r *Registry) register(caller, name string, f factory) {
+	if r == nil {
+		panic(errors.New(caller + ` nil registry`))
+	}
+	if name == `` {
+		panic(errors.New(caller + ` empty name`))
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if _, ok := r.factories[name]; ok {
+		panic(errors.New(caller + ` duplicate name: ` + name))
+	}
+	if r.factories == nil {
+		r.factories = make(map[string]factory)
+	}
+	r.factories[name] = f
+}
+
+// LoadFile reads then loads the given file, see Registry.Load.
+func (r *Registry) LoadFile(filename string) (bt.Node, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return r.Load(filename, data)
+}
+
+// Load builds a tree from a YAML or JSON document (see the package documentation), using the registered factories.
+// Each node will have the name attached (see bt.Node.WithName), defaulting to the type, as well as a frame pointing
+// to the node's location in the document (see bt.Node.WithFrame), with the function set to the type, and the file
+// set to filename, which is otherwise only used for errors.
+func (r *Registry) Load(filename string, data []byte) (bt.Node, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf(`btload.Registry.Load %s: %w`, filename, err)
+	}
+	if document.Kind != yaml.DocumentNode || len(document.Content) != 1 {
+		return nil, fmt.Errorf(`btload.Registry.Load %s: empty document`, filename)
+	}
+	return r.build(filename, document.Content[0])
+}
+
+func (r *Registry) build(filename string, value *yaml.Node) (bt.Node, error) {
+	errorf := func(value *yaml.Node, format string, args ...any) error {
+		return fmt.Errorf(`btload.Registry.Load %s:%d: %s`, filename, value.Line, fmt.Sprintf(format, args...))
+	}
+
+	if value.Kind != yaml.MappingNode {
+		return nil, errorf(value, `expected node to be an object`)
+	}
+
+	var (
+		typ, name string
+		params    *yaml.Node
+		children  []bt.Node
+	)
+	for i := 0; i+1 < len(value.Content); i += 2 {
+		key, field := value.Content[i], value.Content[i+1]
+		switch key.Value {
+		case `type`:
+			typ = field.Value
+		case `name`:
+			name = field.Value
+		case `params`:
+			params = field
+		case `children`:
+			if field.Kind != yaml.SequenceNode {
+				return nil, errorf(field, `expected children to be an array`)
+			}
+			for _, child := range field.Content {
+				node, err := r.build(filename, child)
+				if err != nil {
+					return nil, err
+				}
+				children = append(children, node)
+			}
+		default:
+			return nil, errorf(key, `unknown field %q`, key.Value)
+		}
+	}
+	if typ == `` {
+		return nil, errorf(value, `missing type`)
+	}
+
+	r.mutex.RLock()
+	f := r.factories[typ]
+	r.mutex.RUnlock()
+	if f == nil {
+		return nil, errorf(value, `unknown type %q`, typ)
+	}
+
+	tick, children, err := f(func(v any) error {
+		if params == nil {
+			return nil
+		}
+		var raw any
+		if err := params.Decode(&raw); err != nil {
+			return err
+		}
+		b, err := json.Marshal(raw)
+		if err != nil {
+			return err
+		}
+		decoder := json.NewDecoder(bytes.NewReader(b))
+		decoder.DisallowUnknownFields()
+		return decoder.Decode(v)
+	}, children)
+	if err != nil {
+		return nil, errorf(value, `%s: %s`, typ, err)
+	}
+	if tick == nil {
+		return nil, errorf(value, `%s: nil tick`, typ)
+	}
+
+	if name == `` {
+		name = typ
+	}
+	return bt.New(tick, children...).
+		WithName(name).
+		WithFrame(&bt.Frame{Function: typ, File: filename, Line: value.Line}), nil
+}
@@ -0,0 +1,252 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package btload
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+)
+
+func newTestRegistry(events *[]string) *Registry {
+	r := NewRegistry()
+	Register(r, `Log`, func(p struct {
+		Message string `json:"message"`
+		Status  string `json:"status"`
+	}) (bt.Tick, error) {
+		status := bt.Success
+		switch p.Status {
+		case ``, `success`:
+		case `failure`:
+			status = bt.Failure
+		default:
+			return nil, errors.New(`invalid status: ` + p.Status)
+		}
+		return func([]bt.Node) (bt.Status, error) {
+			*events = append(*events, p.Message)
+			return status, nil
+		}, nil
+	})
+	return r
+}
+
+func TestRegistry_Load_yaml(t *testing.T) {
+	var events []string
+	node, err := newTestRegistry(&events).Load(`tree.yaml`, []byte(`type: Selector
+name: root
+children:
+  - type: Memorize
+    children:
+      - type: Sequence
+        children:
+          - type: Log
+            params: {message: a}
+          - type: Log
+            params: {message: b, status: failure}
+  - type: Not
+    children:
+      - type: Log
+        name: c
+        params:
+          message: c
+          status: failure
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status, err := node.Tick(); err != nil || status != bt.Success {
+		t.Fatal(status, err)
+	}
+	if !reflect.DeepEqual(events, []string{`a`, `b`, `c`}) {
+		t.Error(events)
+	}
+	if v := node.Name(); v != `root` {
+		t.Error(v)
+	}
+	if v := node.Frame(); v == nil || *v != (bt.Frame{Function: `Selector`, File: `tree.yaml`, Line: 1}) {
+		t.Error(v)
+	}
+	_, children := node()
+	if len(children) != 2 {
+		t.Fatal(children)
+	}
+	if v := children[0].Name(); v != `Memorize` {
+		t.Error(v)
+	}
+	if v := children[0].Frame(); v == nil || v.Line != 4 || v.Function != `Memorize` {
+		t.Error(v)
+	}
+	if _, grandchildren := children[0](); len(grandchildren) != 2 || grandchildren[1].Name() != `Log` || grandchildren[1].Frame().Line != 10 {
+		t.Error(grandchildren)
+	}
+	if _, grandchildren := children[1](); len(grandchildren) != 0 {
+		t.Error(grandchildren)
+	}
+}
+
+func TestRegistry_Load_json(t *testing.T) {
+	var events []string
+	node, err := newTestRegistry(&events).Load(`tree.json`, []byte(`{
+  "type": "Parallel",
+  "params": {"success": -1, "failure": 1},
+  "children": [
+    {"type": "Log", "params": {"message": "a"}},
+    {"type": "RepeatN", "params": {"n": 2}, "children": [{"type": "Log", "params": {"message": "b"}}]}
+  ]
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status, err := node.Tick(); err != nil || status != bt.Running {
+		t.Fatal(status, err)
+	}
+	if status, err := node.Tick(); err != nil || status != bt.Success {
+		t.Fatal(status, err)
+	}
+	if !reflect.DeepEqual(events, []string{`a`, `b`, `b`}) {
+		t.Error(events)
+	}
+	if v := node.Frame(); v == nil || v.File != `tree.json` || v.Line != 1 {
+		t.Error(v)
+	}
+}
+
+func TestRegistry_Load_builtins(t *testing.T) {
+	for _, typ := range []string{`Sequence`, `Selector`, `ReactiveSequence`, `ReactiveSelector`, `SequenceWithMemory`, `SelectorWithMemory`, `All`, `Switch`, `Fork`} {
+		if _, err := NewRegistry().Load(``, []byte(`type: `+typ)); err != nil {
+			t.Error(typ, err)
+		}
+	}
+	for _, typ := range []string{`Memorize`, `Async`, `Not`, `Any`, `Shuffle`, `RepeatUntilFailure`, `RepeatUntilSuccess`, `ForceSuccess`, `ForceFailure`} {
+		if _, err := NewRegistry().Load(``, []byte(`{type: `+typ+`, children: [{type: Sequence}]}`)); err != nil {
+			t.Error(typ, err)
+		}
+	}
+	node, err := NewRegistry().Load(``, []byte(`{type: Timeout, params: {duration: 1m}, children: [{type: RateLimit, params: {duration: 1h}}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []bt.Status{bt.Success, bt.Failure} {
+		if status, err := node.Tick(); err != nil || status != expected {
+			t.Error(status, err)
+		}
+	}
+	node, err = NewRegistry().Load(``, []byte(`{type: Cooldown, params: {duration: 1h}, children: [{type: Debounce, params: {duration: 0s}, children: [{type: Sequence}]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []bt.Status{bt.Success, bt.Failure} {
+		if status, err := node.Tick(); err != nil || status != expected {
+			t.Error(status, err)
+		}
+	}
+}
+
+func TestRegistry_Load_errors(t *testing.T) {
+	var events []string
+	r := newTestRegistry(&events)
+	for _, tc := range []struct {
+		Name string
+		Data string
+		Err  string
+	}{
+		{`invalid`, `[`, `btload.Registry.Load f: yaml: line 1: did not find expected node content`},
+		{`empty`, ``, `btload.Registry.Load f: empty document`},
+		{`not object`, `[]`, `btload.Registry.Load f:1: expected node to be an object`},
+		{`missing type`, `name: a`, `btload.Registry.Load f:1: missing type`},
+		{`unknown type`, "type: Sequence\nchildren:\n  - type: Nope", `btload.Registry.Load f:3: unknown type "Nope"`},
+		{`unknown field`, "type: Sequence\nnope: 1", `btload.Registry.Load f:2: unknown field "nope"`},
+		{`children`, `{type: Sequence, children: {}}`, `btload.Registry.Load f:1: expected children to be an array`},
+		{`unknown param`, `{type: Log, params: {nope: 1}}`, `btload.Registry.Load f:1: Log: json: unknown field "nope"`},
+		{`factory error`, `{type: Log, params: {status: running}}`, `btload.Registry.Load f:1: Log: invalid status: running`},
+		{`decorator`, `{type: Not}`, `btload.Registry.Load f:1: Not: decorator must have exactly one child`},
+		{`parallel`, `{type: Parallel}`, `btload.Registry.Load f:1: Parallel: success and failure thresholds are required`},
+		{`timeout`, `{type: Timeout, children: [{type: Sequence}]}`, `btload.Registry.Load f:1: Timeout: duration must be positive`},
+		{`duration`, `{type: RateLimit, params: {duration: true}}`, `btload.Registry.Load f:1: RateLimit: btload.Duration invalid value: true`},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			if node, err := r.Load(`f`, []byte(tc.Data)); node != nil || err == nil || err.Error() != tc.Err {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestRegistry_LoadFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), `tree.yaml`)
+	if err := os.WriteFile(filename, []byte(`type: Sequence`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	node, err := NewRegistry().LoadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := node.Frame(); v == nil || v.File != filename {
+		t.Error(v)
+	}
+	if _, err := NewRegistry().LoadFile(filename + `.missing`); !errors.Is(err, os.ErrNotExist) {
+		t.Error(err)
+	}
+}
+
+func TestRegister_panic(t *testing.T) {
+	tick := func(struct{}) (bt.Tick, error) { return bt.Sequence, nil }
+	decorator := func(_ struct{}, tick bt.Tick) (bt.Tick, error) { return tick, nil }
+	for _, tc := range []struct {
+		Panic string
+		Fn    func()
+	}{
+		{`btload.Register nil registry`, func() { Register(nil, `a`, tick) }},
+		{`btload.Register empty name`, func() { Register(NewRegistry(), ``, tick) }},
+		{`btload.Register nil factory`, func() { Register[struct{}](NewRegistry(), `a`, nil) }},
+		{`btload.Register duplicate name: Sequence`, func() { Register(NewRegistry(), `Sequence`, tick) }},
+		{`btload.RegisterDecorator nil factory`, func() { RegisterDecorator[struct{}](NewRegistry(), `a`, nil) }},
+		{`btload.RegisterDecorator duplicate name: Not`, func() { RegisterDecorator(NewRegistry(), `Not`, decorator) }},
+	} {
+		t.Run(tc.Panic, func(t *testing.T) {
+			defer func() {
+				if s := fmt.Sprint(recover()); s != tc.Panic {
+					t.Error(s)
+				}
+			}()
+			tc.Fn()
+			t.Error(`expected a panic`)
+		})
+	}
+}
+
+func TestDuration(t *testing.T) {
+	var d Duration
+	if err := d.UnmarshalJSON([]byte(`"1.5s"`)); err != nil || d.Duration() != time.Second*3/2 {
+		t.Error(d, err)
+	}
+	if err := d.UnmarshalJSON([]byte(`1000`)); err != nil || d.Duration() != time.Microsecond {
+		t.Error(d, err)
+	}
+	if err := d.UnmarshalJSON([]byte(`"nope"`)); err == nil {
+		t.Error(`expected error`)
+	}
+	if b, err := Duration(time.Minute).MarshalJSON(); err != nil || string(b) != `"1m0s"` {
+		t.Error(string(b), err)
+	}
+}
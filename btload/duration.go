@@ -0,0 +1,57 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package btload
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Duration is a time.Duration that may be used as a parameter, and is decoded from either a string, as per
+// time.ParseDuration (e.g. "1.5s"), or a number of nanoseconds.
+type Duration time.Duration
+
+// Duration returns the receiver as a time.Duration.
+func (d Duration) Duration() time.Duration { return time.Duration(d) }
+
+// MarshalJSON implements json.Marshaler, encoding the receiver as a string, see time.Duration.String.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case float64:
+		*d = Duration(v)
+		return nil
+	case string:
+		value, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		*d = Duration(value)
+		return nil
+	default:
+		return errors.New(`btload.Duration invalid value: ` + string(b))
+	}
+}
@@ -0,0 +1,13 @@
+module github.com/joeycumines/go-behaviortree/btload
+
+go 1.25.6
+
+require (
+	github.com/joeycumines/go-behaviortree v0.0.0-20261016073250-10d1781e3aea
+	go.yaml.in/yaml/v3 v3.0.5
+)
+
+require github.com/joeycumines/go-bigbuff v1.21.0 // indirect
+
+// replace is used only for local development, and is ignored by dependents
+replace github.com/joeycumines/go-behaviortree => ../
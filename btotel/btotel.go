@@ -0,0 +1,116 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package btotel provides an OpenTelemetry integration for behavior trees, built using the behaviortree package.
+package btotel
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// AttributeStatus is the span attribute containing the status of the tick, see bt.Status.String
+	AttributeStatus = attribute.Key(`bt.status`)
+	// AttributeName is the span attribute containing the name of the node, if any, see bt.GetName
+	AttributeName = attribute.Key(`bt.name`)
+	// AttributeFunction is the span attribute containing the function of the node's frame, see bt.Node.Frame
+	AttributeFunction = attribute.Key(`code.function`)
+	// AttributeFile is the span attribute containing the file of the node's frame, see bt.Node.Frame
+	AttributeFile = attribute.Key(`code.filepath`)
+	// AttributeLine is the span attribute containing the line of the node's frame, see bt.Node.Frame
+	AttributeLine = attribute.Key(`code.lineno`)
+)
+
+type (
+	// tracer implements bt.Tracer, starting a span per tick, carried by the context passed to children
+	tracer struct {
+		ctx    context.Context
+		tracer trace.Tracer
+	}
+)
+
+var (
+	_ bt.Tracer = (*tracer)(nil)
+)
+
+// NewTracer returns a bt.Tracer (see bt.Trace) that records one span per tick, via t, with spans for children
+// parented by the span of the node that ticked them, meaning each tick of the root node will be recorded as a single
+// trace (or subtree of ctx's span), e.g. suitable for visualisation as a flame graph. Spans are named using
+// bt.GetName, falling back to the function of bt.Node.Frame, and have the attributes defined by this package. Ticks
+// that return an error will have that error recorded, and the span status set accordingly.
+//
+// Spans are carried by the context provided by bt.Trace, meaning children ticked concurrently (e.g. by bt.Fork) will
+// be attributed to the correct parent. Note that identifying nodes uses the Value mechanism, and is subject to the
+// same performance limitations. A panic will occur if ctx or t are nil.
+func NewTracer(ctx context.Context, t trace.Tracer) bt.Tracer {
+	if ctx == nil {
+		panic(errors.New(`btotel.NewTracer nil context`))
+	}
+	if t == nil {
+		panic(errors.New(`btotel.NewTracer nil tracer`))
+	}
+	return &tracer{ctx: ctx, tracer: t}
+}
+
+func (x *tracer) OnTickStart(ctx context.Context, node bt.Node, path []int) context.Context {
+	if len(path) == 0 {
+		// the root is parented by the configured context
+		ctx = x.ctx
+	}
+	name, attributes := describe(node)
+	ctx, _ = x.tracer.Start(ctx, name, trace.WithAttributes(attributes...))
+	return ctx
+}
+
+func (x *tracer) OnTickEnd(ctx context.Context, node bt.Node, status bt.Status, err error, elapsed time.Duration) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(AttributeStatus.String(status.String()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// describe returns the span name and initial attributes for node
+func describe(node bt.Node) (name string, attributes []attribute.KeyValue) {
+	if v := bt.GetName(node); v != `` {
+		name = v
+		attributes = append(attributes, AttributeName.String(v))
+	}
+	if f := node.Frame(); f != nil {
+		if name == `` {
+			name = f.Function
+		}
+		attributes = append(
+			attributes,
+			AttributeFunction.String(f.Function),
+			AttributeFile.String(f.File),
+			AttributeLine.Int(f.Line),
+		)
+	}
+	if name == `` {
+		name = `tick`
+	}
+	return
+}
@@ -0,0 +1,237 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package btotel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	bt "github.com/joeycumines/go-behaviortree"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func attributeValue(span sdktrace.ReadOnlySpan, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestNewTracer(t *testing.T) {
+	var (
+		recorder = tracetest.NewSpanRecorder()
+		provider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		expected = errors.New(`some_error`)
+		node     = bt.Trace(bt.New(
+			bt.Sequence,
+			bt.New(func([]bt.Node) (bt.Status, error) { return bt.Success, nil }).WithName(`a`),
+			bt.New(func([]bt.Node) (bt.Status, error) { return bt.Failure, expected }),
+		).WithName(`root`), NewTracer(context.Background(), provider.Tracer(`test`)))
+	)
+	if status, err := node.Tick(); err != expected || status != bt.Failure {
+		t.Fatal(status, err)
+	}
+	spans := recorder.Ended()
+	if len(spans) != 3 {
+		t.Fatal(len(spans))
+	}
+	a, b, root := spans[0], spans[1], spans[2]
+	if v := root.Name(); v != `root` {
+		t.Error(v)
+	}
+	if v := a.Name(); v != `a` {
+		t.Error(v)
+	}
+	if v := b.Name(); !strings.HasPrefix(v, `github.com/joeycumines/go-behaviortree/btotel.TestNewTracer`) {
+		t.Error(v)
+	}
+	if root.Parent().IsValid() {
+		t.Error(root.Parent())
+	}
+	for _, span := range []sdktrace.ReadOnlySpan{a, b} {
+		if span.Parent().SpanID() != root.SpanContext().SpanID() {
+			t.Error(span.Name(), span.Parent())
+		}
+		if v, ok := attributeValue(span, AttributeFile); !ok || !strings.HasSuffix(v.AsString(), `btotel_test.go`) {
+			t.Error(span.Name(), v, ok)
+		}
+		if v, ok := attributeValue(span, AttributeLine); !ok || v.AsInt64() == 0 {
+			t.Error(span.Name(), v, ok)
+		}
+	}
+	if v, ok := attributeValue(a, AttributeName); !ok || v.AsString() != `a` {
+		t.Error(v, ok)
+	}
+	if _, ok := attributeValue(b, AttributeName); ok {
+		t.Error(`unexpected name`)
+	}
+	for span, status := range map[sdktrace.ReadOnlySpan]string{a: `success`, b: `failure`, root: `failure`} {
+		if v, _ := attributeValue(span, AttributeStatus); v.AsString() != status {
+			t.Error(span.Name(), v.AsString())
+		}
+	}
+	if v := a.Status().Code; v != codes.Unset {
+		t.Error(v)
+	}
+	if v := b.Status(); v.Code != codes.Error || v.Description != `some_error` {
+		t.Error(v)
+	}
+	if v := b.Events(); len(v) != 1 || v[0].Name != `exception` {
+		t.Error(v)
+	}
+	if b.EndTime().Before(b.StartTime()) {
+		t.Error(b.StartTime(), b.EndTime())
+	}
+}
+
+func TestNewTracer_parentContext(t *testing.T) {
+	var (
+		recorder = tracetest.NewSpanRecorder()
+		provider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	)
+	ctx, parent := provider.Tracer(`test`).Start(context.Background(), `parent`)
+	node := bt.Trace(bt.New(bt.Sequence), NewTracer(ctx, provider.Tracer(`test`)))
+	for i := 0; i < 2; i++ {
+		if _, err := node.Tick(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	parent.End()
+	spans := recorder.Ended()
+	if len(spans) != 3 {
+		t.Fatal(len(spans))
+	}
+	for _, span := range spans[:2] {
+		if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Error(span.Parent())
+		}
+	}
+}
+
+func TestNewTracer_fork(t *testing.T) {
+	const ticks = 3
+	var (
+		recorder = tracetest.NewSpanRecorder()
+		provider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		ready    sync.WaitGroup
+		leaf     = func(name string) bt.Node {
+			var count int
+			return bt.New(
+				bt.Sequence,
+				bt.New(func([]bt.Node) (bt.Status, error) {
+					// ensure both children are ticking concurrently
+					ready.Done()
+					ready.Wait()
+					if count++; count < ticks {
+						return bt.Running, nil
+					}
+					return bt.Success, nil
+				}).WithName(name+`_leaf`),
+			).WithName(name)
+		}
+		// the fork isn't the root, and retains it's running children, across ticks
+		node = bt.Trace(
+			bt.New(bt.Sequence, bt.New(bt.Fork(), leaf(`a`), leaf(`b`)).WithName(`fork`)).WithName(`root`),
+			NewTracer(context.Background(), provider.Tracer(`test`)),
+		)
+	)
+	for i := 1; i <= ticks; i++ {
+		ready.Add(2)
+		expected := bt.Running
+		if i == ticks {
+			expected = bt.Success
+		}
+		if status, err := node.Tick(); err != nil || status != expected {
+			t.Fatal(i, status, err)
+		}
+	}
+	var (
+		spans  = make(map[trace.SpanID]sdktrace.ReadOnlySpan)
+		traces = make(map[trace.TraceID]int)
+	)
+	for _, span := range recorder.Ended() {
+		spans[span.SpanContext().SpanID()] = span
+		traces[span.SpanContext().TraceID()]++
+	}
+	if len(spans) != ticks*6 || len(recorder.Started()) != ticks*6 {
+		t.Fatal(len(spans), len(recorder.Started()))
+	}
+	// each tick is a separate trace, of every node
+	if len(traces) != ticks {
+		t.Fatal(traces)
+	}
+	for _, count := range traces {
+		if count != 6 {
+			t.Error(traces)
+		}
+	}
+	for _, span := range spans {
+		expected, ok := map[string]string{
+			`fork`:   `root`,
+			`a`:      `fork`,
+			`b`:      `fork`,
+			`a_leaf`: `a`,
+			`b_leaf`: `b`,
+		}[span.Name()]
+		if !ok {
+			if span.Name() != `root` || span.Parent().IsValid() {
+				t.Error(span.Name(), span.Parent())
+			}
+			continue
+		}
+		// the parent must be of the same tick, i.e. not ended prior to the span starting
+		if parent, ok := spans[span.Parent().SpanID()]; !ok || parent.Name() != expected ||
+			parent.EndTime().Before(span.StartTime()) {
+			t.Error(span.Name(), span.Parent())
+		}
+	}
+}
+
+func TestNewTracer_panic(t *testing.T) {
+	for _, tc := range []struct {
+		Name  string
+		Ctx   context.Context
+		Panic string
+	}{
+		{`nil context`, nil, `btotel.NewTracer nil context`},
+		{`nil tracer`, context.Background(), `btotel.NewTracer nil tracer`},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			defer func() {
+				if s := fmt.Sprint(recover()); s != tc.Panic {
+					t.Error(s)
+				}
+			}()
+			if tc.Ctx == nil {
+				NewTracer(tc.Ctx, sdktrace.NewTracerProvider().Tracer(`test`))
+			} else {
+				NewTracer(tc.Ctx, nil)
+			}
+			t.Error(`expected a panic`)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+module github.com/joeycumines/go-behaviortree/btotel
+
+go 1.25.6
+
+require (
+	github.com/joeycumines/go-behaviortree v0.0.0-20261016073250-10d1781e3aea
+	go.opentelemetry.io/otel v1.46.0
+	go.opentelemetry.io/otel/sdk v1.46.0
+	go.opentelemetry.io/otel/trace v1.46.0
+)
+
+require (
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/go-logr/logr v1.4.4 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/joeycumines/go-bigbuff v1.21.0 // indirect
+	go.opentelemetry.io/auto/sdk v1.2.1 // indirect
+	go.opentelemetry.io/otel/metric v1.46.0 // indirect
+	golang.org/x/sys v0.47.0 // indirect
+)
+
+// replace is used only for local development, and is ignored by dependents
+replace github.com/joeycumines/go-behaviortree => ../
@@ -0,0 +1,173 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package btprom exposes the statistics of tickers, from the behaviortree package, in the Prometheus text exposition
+// format, without depending on the Prometheus client library.
+//
+// Each metric is labeled with the ticker's name, see behaviortree.NamedManager.AddNamed. Statistics other than whether
+// the ticker is running are only available for tickers that implement behaviortree.StatsReporter, or (for the tick
+// count only) behaviortree.TickCounter.
+package btprom
+
+import (
+	"bufio"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+)
+
+// ContentType is the content type of the text exposition format, as written by Write
+const ContentType = `text/plain; version=0.0.4; charset=utf-8`
+
+type family struct {
+	name  string
+	kind  string
+	help  string
+	write func(w *bufio.Writer, labels string, info bt.TickerInfo, stats *bt.TickerStats)
+}
+
+var families = [...]family{
+	{
+		name: `bt_ticker_running`,
+		kind: `gauge`,
+		help: `Whether the ticker is running.`,
+		write: func(w *bufio.Writer, labels string, info bt.TickerInfo, _ *bt.TickerStats) {
+			var v float64
+			if info.Running {
+				v = 1
+			}
+			writeSample(w, `bt_ticker_running`, labels, v)
+		},
+	},
+	{
+		name: `bt_ticker_ticks_total`,
+		kind: `counter`,
+		help: `Total number of completed ticks.`,
+		write: func(w *bufio.Writer, labels string, info bt.TickerInfo, stats *bt.TickerStats) {
+			if stats != nil {
+				writeSample(w, `bt_ticker_ticks_total`, labels, float64(stats.Ticks))
+			} else if info.Ticks >= 0 {
+				writeSample(w, `bt_ticker_ticks_total`, labels, float64(info.Ticks))
+			}
+		},
+	},
+	{
+		name: `bt_ticker_overruns_total`,
+		kind: `counter`,
+		help: `Total number of ticks that took longer than the interval.`,
+		write: func(w *bufio.Writer, labels string, _ bt.TickerInfo, stats *bt.TickerStats) {
+			if stats != nil {
+				writeSample(w, `bt_ticker_overruns_total`, labels, float64(stats.Overruns))
+			}
+		},
+	},
+	{
+		name: `bt_ticker_dropped_total`,
+		kind: `counter`,
+		help: `Estimated total number of ticks skipped due to overruns.`,
+		write: func(w *bufio.Writer, labels string, _ bt.TickerInfo, stats *bt.TickerStats) {
+			if stats != nil {
+				writeSample(w, `bt_ticker_dropped_total`, labels, float64(stats.Dropped))
+			}
+		},
+	},
+	{
+		name: `bt_ticker_interval_seconds`,
+		kind: `gauge`,
+		help: `Configured interval of the ticker, or zero if it does not tick periodically.`,
+		write: func(w *bufio.Writer, labels string, _ bt.TickerInfo, stats *bt.TickerStats) {
+			if stats != nil {
+				writeSample(w, `bt_ticker_interval_seconds`, labels, seconds(stats.Interval))
+			}
+		},
+	},
+	{
+		name: `bt_ticker_last_tick_seconds`,
+		kind: `gauge`,
+		help: `Duration of the most recent tick.`,
+		write: func(w *bufio.Writer, labels string, _ bt.TickerInfo, stats *bt.TickerStats) {
+			if stats != nil {
+				writeSample(w, `bt_ticker_last_tick_seconds`, labels, seconds(stats.Last))
+			}
+		},
+	},
+	{
+		name: `bt_ticker_tick_seconds`,
+		kind: `histogram`,
+		help: `Duration of each tick.`,
+		write: func(w *bufio.Writer, labels string, _ bt.TickerInfo, stats *bt.TickerStats) {
+			if stats == nil {
+				return
+			}
+			for _, b := range stats.Buckets {
+				le := `,le="` + formatFloat(seconds(b.UpperBound)) + `"`
+				writeSample(w, `bt_ticker_tick_seconds_bucket`, labels+le, float64(b.Count))
+			}
+			writeSample(w, `bt_ticker_tick_seconds_bucket`, labels+`,le="+Inf"`, float64(stats.Ticks))
+			writeSample(w, `bt_ticker_tick_seconds_sum`, labels, seconds(stats.Total))
+			writeSample(w, `bt_ticker_tick_seconds_count`, labels, float64(stats.Ticks))
+		},
+	},
+}
+
+// Write writes the statistics of the given tickers (e.g. from behaviortree.NamedManager.List) to w, in the Prometheus
+// text exposition format.
+func Write(w io.Writer, tickers []bt.TickerInfo) error {
+	var (
+		buf    = bufio.NewWriter(w)
+		labels = make([]string, len(tickers))
+		stats  = make([]*bt.TickerStats, len(tickers))
+	)
+	for i, info := range tickers {
+		labels[i] = `ticker="` + escapeLabel(info.Name) + `"`
+		if v, ok := info.Ticker.(bt.StatsReporter); ok {
+			s := v.Stats()
+			stats[i] = &s
+		}
+	}
+	for _, f := range families {
+		buf.WriteString(`# HELP ` + f.name + ` ` + f.help + "\n")
+		buf.WriteString(`# TYPE ` + f.name + ` ` + f.kind + "\n")
+		for i, info := range tickers {
+			f.write(buf, labels[i], info, stats[i])
+		}
+	}
+	return buf.Flush()
+}
+
+// Handler returns an http.Handler that serves the statistics of the named tickers of m, see Write.
+func Handler(m bt.NamedManager) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(`Content-Type`, ContentType)
+		_ = Write(w, m.List())
+	})
+}
+
+func writeSample(w *bufio.Writer, name, labels string, value float64) {
+	w.WriteString(name + `{` + labels + `} ` + formatFloat(value) + "\n")
+}
+
+func seconds(d time.Duration) float64 { return d.Seconds() }
+
+func formatFloat(v float64) string { return strconv.FormatFloat(v, 'g', -1, 64) }
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabel(s string) string { return labelEscaper.Replace(s) }
@@ -0,0 +1,127 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package btprom
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+)
+
+type mockTicker struct {
+	bt.Ticker
+	stats bt.TickerStats
+}
+
+func (m mockTicker) Stats() bt.TickerStats { return m.stats }
+
+type countTicker struct{ bt.Ticker }
+
+func (countTicker) Ticks() int64 { return 7 }
+
+func TestWrite(t *testing.T) {
+	var b bytes.Buffer
+	if err := Write(&b, []bt.TickerInfo{
+		{
+			Name:    `a`,
+			Running: true,
+			Ticker: mockTicker{stats: bt.TickerStats{
+				Interval: time.Second,
+				Ticks:    3,
+				Overruns: 1,
+				Dropped:  2,
+				Last:     time.Millisecond * 500,
+				Total:    time.Millisecond * 3500,
+				Max:      time.Millisecond * 2500,
+				Buckets: []bt.StatsBucket{
+					{UpperBound: time.Millisecond, Count: 0},
+					{UpperBound: time.Second, Count: 2},
+				},
+			}},
+		},
+		{Name: "b\"\\\n", Ticks: 7, Ticker: countTicker{}},
+		{Name: `c`, Ticks: -1},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if expected := `# HELP bt_ticker_running Whether the ticker is running.
+# TYPE bt_ticker_running gauge
+bt_ticker_running{ticker="a"} 1
+bt_ticker_running{ticker="b\"\\\n"} 0
+bt_ticker_running{ticker="c"} 0
+# HELP bt_ticker_ticks_total Total number of completed ticks.
+# TYPE bt_ticker_ticks_total counter
+bt_ticker_ticks_total{ticker="a"} 3
+bt_ticker_ticks_total{ticker="b\"\\\n"} 7
+# HELP bt_ticker_overruns_total Total number of ticks that took longer than the interval.
+# TYPE bt_ticker_overruns_total counter
+bt_ticker_overruns_total{ticker="a"} 1
+# HELP bt_ticker_dropped_total Estimated total number of ticks skipped due to overruns.
+# TYPE bt_ticker_dropped_total counter
+bt_ticker_dropped_total{ticker="a"} 2
+# HELP bt_ticker_interval_seconds Configured interval of the ticker, or zero if it does not tick periodically.
+# TYPE bt_ticker_interval_seconds gauge
+bt_ticker_interval_seconds{ticker="a"} 1
+# HELP bt_ticker_last_tick_seconds Duration of the most recent tick.
+# TYPE bt_ticker_last_tick_seconds gauge
+bt_ticker_last_tick_seconds{ticker="a"} 0.5
+# HELP bt_ticker_tick_seconds Duration of each tick.
+# TYPE bt_ticker_tick_seconds histogram
+bt_ticker_tick_seconds_bucket{ticker="a",le="0.001"} 0
+bt_ticker_tick_seconds_bucket{ticker="a",le="1"} 2
+bt_ticker_tick_seconds_bucket{ticker="a",le="+Inf"} 3
+bt_ticker_tick_seconds_sum{ticker="a"} 3.5
+bt_ticker_tick_seconds_count{ticker="a"} 3
+`; b.String() != expected {
+		t.Errorf("unexpected output:\n%s", b.String())
+	}
+}
+
+func TestHandler(t *testing.T) {
+	m := bt.NewManager()
+	defer m.Stop()
+	ticker := bt.NewEventTicker(t.Context(), bt.New(func(children []bt.Node) (bt.Status, error) {
+		return bt.Success, nil
+	}))
+	if err := m.AddNamed(`tree`, ticker); err != nil {
+		t.Fatal(err)
+	}
+	ticker.Wake()
+	for ticker.(bt.TickCounter).Ticks() != 1 {
+		time.Sleep(time.Millisecond)
+	}
+	w := httptest.NewRecorder()
+	Handler(m).ServeHTTP(w, httptest.NewRequest(`GET`, `/metrics`, nil))
+	if v := w.Header().Get(`Content-Type`); v != ContentType {
+		t.Error(v)
+	}
+	for _, expected := range []string{
+		`bt_ticker_running{ticker="tree"} 1`,
+		`bt_ticker_ticks_total{ticker="tree"} 1`,
+		`bt_ticker_interval_seconds{ticker="tree"} 0`,
+		`bt_ticker_tick_seconds_bucket{ticker="tree",le="+Inf"} 1`,
+		`bt_ticker_tick_seconds_count{ticker="tree"} 1`,
+	} {
+		if !strings.Contains(w.Body.String(), expected+"\n") {
+			t.Errorf("missing %q:\n%s", expected, w.Body.String())
+		}
+	}
+}
@@ -0,0 +1,220 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package btrecord provides recording of the status history of behavior trees, built using the behaviortree
+// package, to an append-only log, and replay of that log, for post-mortem analysis, see also cmd/btreplay.
+//
+// The log is JSONL, where the first line is a Header, describing the structure of the tree, and each subsequent line
+// is a Record, of a single tick of the root node.
+package btrecord
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+	"github.com/joeycumines/go-behaviortree/internal/bttrace"
+)
+
+type (
+	// Recorder records each tick of a tree, as it's ticked via Recorder.Node, see NewRecorder
+	Recorder struct {
+		node    bt.Node
+		clock   bt.Clock
+		mutex   sync.Mutex
+		encoder *json.Encoder
+		tick    int
+		started time.Time
+		states  map[string]State
+		err     error
+	}
+
+	// Option configures the behavior of NewRecorder, see WithClock
+	Option = bttrace.Option
+
+	// Header is the first line of a log, describing the structure of the tree
+	Header struct {
+		Tree *Tree `json:"tree"`
+	}
+
+	// Tree models the structure of a tree (see NewTree), e.g. as it was when recording started, where each node is
+	// identified by the path of child indexes from the root, e.g. "0.1.0" is the first child of the second child of
+	// the root, which is "0"
+	Tree struct {
+		ID       string  `json:"id"`
+		Name     string  `json:"name"`
+		Children []*Tree `json:"children,omitempty"`
+	}
+
+	// Record is a line of a log, recording a single tick of the root node
+	Record struct {
+		// Tick is the tick number, starting from 1
+		Tick int `json:"tick"`
+		// Time is when the tick started
+		Time time.Time `json:"time"`
+		// States contains the state of each node that was ticked, keyed by ID, see Tree
+		States map[string]State `json:"states"`
+	}
+
+	// State is the result of ticking a node
+	State struct {
+		Status  bt.Status     `json:"status"`
+		Error   string        `json:"error,omitempty"`
+		Elapsed time.Duration `json:"elapsed"`
+	}
+
+	// Reader reads a log, see NewReader
+	Reader struct {
+		scanner *bufio.Scanner
+		header  Header
+	}
+)
+
+// NewRecorder constructs a new Recorder, writing the header, describing the current structure of node, to w, and
+// will return an error if that fails. Records are written after each tick of the root node (see Recorder.Node), and
+// the first write error (after which no further records are written) is available via Recorder.Err. Note that a
+// panic will occur if w or node are nil.
+func NewRecorder(w io.Writer, node bt.Node, options ...Option) (*Recorder, error) {
+	if w == nil {
+		panic(errors.New(`btrecord.NewRecorder nil writer`))
+	}
+	if node == nil {
+		panic(errors.New(`btrecord.NewRecorder nil node`))
+	}
+	c := bttrace.NewConfig(options)
+	r := &Recorder{encoder: json.NewEncoder(w), clock: c.Clock}
+	if err := r.encoder.Encode(Header{Tree: NewTree(node)}); err != nil {
+		return nil, err
+	}
+	r.node = bt.Trace(node, bttrace.Tracer{Start: r.start, End: r.end}, bt.WithClock(r.clock))
+	return r, nil
+}
+
+// WithClock configures the clock used to timestamp records, and measure the time each node took to tick, see
+// bt.WithClock.
+func WithClock(clock bt.Clock) Option {
+	return bttrace.WithClock(clock)
+}
+
+// Node returns the instrumented node, which should be ticked (e.g. by a bt.Ticker) in place of the original.
+func (r *Recorder) Node() bt.Node {
+	return r.node
+}
+
+// Err returns the first error that occurred writing a record, if any.
+func (r *Recorder) Err() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.err
+}
+
+// NewTree returns the structure of node, as it would be ticked, i.e. the children of each node, as returned by
+// expanding it, rather than the logical structure (see bt.Walk), such that the ID of each node corresponds to it's
+// State. Note that a panic will occur if node is nil.
+func NewTree(node bt.Node) *Tree {
+	if node == nil {
+		panic(errors.New(`btrecord.NewTree nil node`))
+	}
+	return newTree(node, bttrace.RootID)
+}
+
+func newTree(node bt.Node, id string) *Tree {
+	t := Tree{ID: id, Name: bt.MetadataLabel(node)}
+	if node != nil {
+		_, children := node()
+		for i, child := range children {
+			t.Children = append(t.Children, newTree(child, bttrace.ChildID(id, i)))
+		}
+	}
+	return &t
+}
+
+// start implements bttrace.Tracer.Start, starting a record for each tick of the root
+func (r *Recorder) start(id string) {
+	if id != bttrace.RootID {
+		return
+	}
+	now := r.clock.Now()
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.started = now
+	r.states = make(map[string]State)
+}
+
+// end implements bttrace.Tracer.End, recording the state of the node, and writing the record after each tick of the
+// root
+func (r *Recorder) end(id string, status bt.Status, err error, elapsed time.Duration) {
+	state := State{Status: status, Elapsed: elapsed}
+	if err != nil {
+		state.Error = err.Error()
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.states != nil {
+		r.states[id] = state
+	}
+	if id == bttrace.RootID {
+		r.tick++
+		if r.err == nil {
+			r.err = r.encoder.Encode(Record{Tick: r.tick, Time: r.started, States: r.states})
+		}
+		r.states = nil
+	}
+}
+
+// NewReader constructs a new Reader, reading the header from r, see Reader.Header and Reader.Next.
+func NewReader(r io.Reader) (*Reader, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<26)
+	result := Reader{scanner: scanner}
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New(`btrecord.NewReader missing header`)
+	}
+	if err := json.Unmarshal(scanner.Bytes(), &result.header); err != nil {
+		return nil, err
+	}
+	if result.header.Tree == nil {
+		return nil, errors.New(`btrecord.NewReader missing tree`)
+	}
+	return &result, nil
+}
+
+// Header returns the header of the log.
+func (r *Reader) Header() Header {
+	return r.header
+}
+
+// Next reads the next record, returning io.EOF if there are no more.
+func (r *Reader) Next() (*Record, error) {
+	if !r.scanner.Scan() {
+		if err := r.scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	var record Record
+	if err := json.Unmarshal(r.scanner.Bytes(), &record); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
@@ -0,0 +1,221 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package btrecord
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+	"github.com/joeycumines/go-behaviortree/bttest"
+)
+
+func newTestTree(statuses ...bt.Status) bt.Node {
+	var count int
+	return bt.New(
+		bt.Selector,
+		bt.New(func(children []bt.Node) (bt.Status, error) {
+			status := statuses[count%len(statuses)]
+			count++
+			return status, nil
+		}).WithName(`a`),
+		bt.New(func(children []bt.Node) (bt.Status, error) {
+			return bt.Failure, errors.New(`some error`)
+		}).WithName(`b`),
+	).WithName(`root`)
+}
+
+func TestRecorder(t *testing.T) {
+	var b bytes.Buffer
+	r, err := NewRecorder(&b, newTestTree(bt.Success, bt.Failure))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status, err := r.Node().Tick(); err != nil || status != bt.Success {
+		t.Fatal(status, err)
+	}
+	if status, err := r.Node().Tick(); err == nil || status != bt.Failure {
+		t.Fatal(status, err)
+	}
+	if err := r.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(b.String(), "\n"); n != 3 {
+		t.Fatal(n, b.String())
+	}
+
+	reader, err := NewReader(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree := reader.Header().Tree
+	if tree.ID != `0` || tree.Name != `root` || len(tree.Children) != 2 ||
+		tree.Children[0].ID != `0.0` || tree.Children[0].Name != `a` ||
+		tree.Children[1].ID != `0.1` || tree.Children[1].Name != `b` {
+		t.Fatalf(`%+v`, tree)
+	}
+
+	record, err := reader.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Tick != 1 || len(record.States) != 2 ||
+		record.States[`0`].Status != bt.Success ||
+		record.States[`0.0`].Status != bt.Success {
+		t.Fatalf(`%+v`, record)
+	}
+	if _, ok := record.States[`0.1`]; ok {
+		t.Error(`unexpected state`)
+	}
+
+	record, err = reader.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Tick != 2 || len(record.States) != 3 ||
+		record.States[`0`].Error != `some error` ||
+		record.States[`0.0`].Status != bt.Failure ||
+		record.States[`0.1`].Status != bt.Failure ||
+		record.States[`0.1`].Error != `some error` {
+		t.Fatalf(`%+v`, record)
+	}
+
+	if _, err := reader.Next(); err != io.EOF {
+		t.Fatal(err)
+	}
+}
+
+func TestRecorder_clock(t *testing.T) {
+	var (
+		b     bytes.Buffer
+		epoch = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		clock = bttest.NewClock(epoch)
+	)
+	r, err := NewRecorder(&b, bt.New(func([]bt.Node) (bt.Status, error) {
+		clock.Advance(time.Second)
+		return bt.Success, nil
+	}), WithClock(clock))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status, err := r.Node().Tick(); err != nil || status != bt.Success {
+		t.Fatal(status, err)
+	}
+	reader, err := NewReader(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	record, err := reader.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !record.Time.Equal(epoch) || record.States[`0`].Elapsed != time.Second {
+		t.Errorf(`%+v`, record)
+	}
+}
+
+type errWriter struct{ n int }
+
+func (x *errWriter) Write(p []byte) (int, error) {
+	if x.n == 0 {
+		return 0, errors.New(`some write error`)
+	}
+	x.n--
+	return len(p), nil
+}
+
+func TestRecorder_Err(t *testing.T) {
+	if _, err := NewRecorder(&errWriter{}, newTestTree(bt.Success)); err == nil || err.Error() != `some write error` {
+		t.Fatal(err)
+	}
+	r, err := NewRecorder(&errWriter{n: 1}, newTestTree(bt.Success))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status, err := r.Node().Tick(); err != nil || status != bt.Success {
+		t.Fatal(status, err)
+	}
+	if err := r.Err(); err == nil || err.Error() != `some write error` {
+		t.Fatal(err)
+	}
+}
+
+func TestNewReader_errors(t *testing.T) {
+	for _, input := range []string{``, `{}`, `not json`} {
+		if _, err := NewReader(strings.NewReader(input)); err == nil {
+			t.Error(input)
+		}
+	}
+}
+
+func TestPrinter(t *testing.T) {
+	tree := &Tree{ID: `0`, Name: `root`, Children: []*Tree{{ID: `0.0`, Name: `a`}, {ID: `0.1`, Name: `b`}}}
+	record := &Record{Tick: 1, States: map[string]State{
+		`0`:   {Status: bt.Failure, Error: `some error`, Elapsed: 3},
+		`0.0`: {Status: bt.Failure, Elapsed: 2},
+	}}
+	var b bytes.Buffer
+	if err := Printer(record).Fprint(&b, tree.Node()); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "[0   failure]  root | 3ns | error: some error\n" +
+		"├── [0.0 failure]  a | 2ns\n" +
+		"└── [0.1 -      ]  b"; b.String() != expected {
+		t.Errorf("unexpected output:\n%s", b.String())
+	}
+}
+
+func TestReplay(t *testing.T) {
+	var b bytes.Buffer
+	r, err := NewRecorder(&b, newTestTree(bt.Success, bt.Failure, bt.Success))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		_, _ = r.Node().Tick()
+	}
+	log := b.String()
+	for _, tc := range []struct {
+		tick     int
+		expected []string
+	}{
+		{1, []string{"tick 1 ", "[0.0 success]  a", "[0.1 -      ]  b"}},
+		{2, []string{"tick 2 ", "[0.0 failure]  a", "[0.1 failure]  b", "error: some error"}},
+		{0, []string{"tick 3 ", "[0.0 success]  a"}},
+	} {
+		var output bytes.Buffer
+		if err := Replay(&output, strings.NewReader(log), tc.tick); err != nil {
+			t.Fatal(tc.tick, err)
+		}
+		for _, expected := range tc.expected {
+			if !strings.Contains(output.String(), expected) {
+				t.Errorf("tick %d missing %q:\n%s", tc.tick, expected, output.String())
+			}
+		}
+	}
+	if err := Replay(io.Discard, strings.NewReader(log), 4); err == nil || err.Error() != `btrecord.Replay tick 4 not found` {
+		t.Error(err)
+	}
+	header, _, _ := strings.Cut(log, "\n")
+	if err := Replay(io.Discard, strings.NewReader(header), 0); err == nil || err.Error() != `btrecord.Replay no records` {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,111 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package btrecord
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	bt "github.com/joeycumines/go-behaviortree"
+)
+
+// vkTree is the value key used to associate replayed nodes with their Tree
+type vkTree struct{}
+
+// Printer returns a bt.TreePrinter that renders the state of each node, as of the given record, which may be nil,
+// for use with nodes from Tree.Node.
+func Printer(record *Record) bt.TreePrinter {
+	return bt.TreePrinter{
+		Inspector: Inspector(record),
+		Formatter: bt.DefaultPrinterFormatter,
+	}
+}
+
+// Inspector returns a status-aware bt.TreePrinter.Inspector, for use with nodes from Tree.Node, where the meta is the
+// ID and status of each node, and the value is the name, followed by the elapsed time and any error, if the node was
+// ticked, as of the given record, which may be nil.
+func Inspector(record *Record) func(node bt.Node, tick bt.Tick) ([]any, any) {
+	return func(node bt.Node, tick bt.Tick) ([]any, any) {
+		t, _ := node.Value(vkTree{}).(*Tree)
+		if t == nil {
+			return bt.DefaultPrinterInspector(node, tick)
+		}
+		var state State
+		var ok bool
+		if record != nil {
+			state, ok = record.States[t.ID]
+		}
+		if !ok {
+			return []any{t.ID, `-`}, t.Name
+		}
+		value := t.Name + ` | ` + state.Elapsed.String()
+		if state.Error != `` {
+			value += ` | error: ` + state.Error
+		}
+		return []any{t.ID, state.Status}, value
+	}
+}
+
+// Node returns a node modeling the receiver, which may be printed (but not ticked), see Printer.
+func (t *Tree) Node() bt.Node {
+	if t == nil {
+		return nil
+	}
+	var children []bt.Node
+	for _, child := range t.Children {
+		children = append(children, child.Node())
+	}
+	return bt.Node(func() (bt.Tick, []bt.Node) { return nil, children }).WithValue(vkTree{}, t)
+}
+
+// Replay reads a log from r, and writes the state of the tree as of the given tick to w, where a tick less than 1
+// will use the last record, see Printer.
+func Replay(w io.Writer, r io.Reader, tick int) error {
+	reader, err := NewReader(r)
+	if err != nil {
+		return err
+	}
+	var record *Record
+	for {
+		next, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		record = next
+		if tick > 0 && record.Tick >= tick {
+			break
+		}
+	}
+	if record == nil {
+		return errors.New(`btrecord.Replay no records`)
+	}
+	if tick > 0 && record.Tick != tick {
+		return fmt.Errorf(`btrecord.Replay tick %d not found`, tick)
+	}
+	if _, err := fmt.Fprintf(w, "tick %d at %s\n", record.Tick, record.Time.Format(`2006-01-02T15:04:05.000Z07:00`)); err != nil {
+		return err
+	}
+	if err := Printer(record).Fprint(w, reader.Header().Tree.Node()); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,215 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package bttest provides utilities for testing behavior trees, built using the behaviortree package.
+package bttest
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+)
+
+type (
+	// Clock is a manually controlled implementation of behaviortree.Clock, which only advances when instructed to,
+	// see NewClock, Clock.Advance, and Clock.Set. It is safe for concurrent use.
+	Clock struct {
+		mu      sync.Mutex
+		now     time.Time
+		waiters []*waiter
+	}
+
+	// waiter models a timer or ticker, sending on ch at when, and (for tickers) every period after that
+	waiter struct {
+		clock  *Clock
+		ch     chan time.Time
+		when   time.Time
+		period time.Duration
+		active bool
+	}
+
+	timer struct{ *waiter }
+
+	ticker struct{ *waiter }
+)
+
+var (
+	_ bt.Clock       = (*Clock)(nil)
+	_ bt.ClockTimer  = timer{}
+	_ bt.ClockTicker = ticker{}
+)
+
+// NewClock constructs a new Clock, starting at the given time.
+func NewClock(now time.Time) *Clock {
+	return &Clock{now: now}
+}
+
+// Now implements behaviortree.Clock.Now, returning the current (manually controlled) time.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance moves the clock forward by d, see also Clock.Set.
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.set(c.now.Add(d))
+}
+
+// Set moves the clock to the given time, firing any timers or tickers due in the interim, in chronological order.
+// Like the time package, sends are non-blocking, i.e. ticks will be dropped if the receiver hasn't kept up. Setting
+// a time prior to the current time is allowed, and will not fire anything.
+func (c *Clock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.set(now)
+}
+
+// Waiters returns the number of active timers and tickers, which may be used to synchronise with the code under
+// test, e.g. to wait for a timer to be started, prior to advancing the clock.
+func (c *Clock) Waiters() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.waiters)
+}
+
+// NewTicker implements behaviortree.Clock.NewTicker, and will panic if d <= 0, like time.NewTicker.
+func (c *Clock) NewTicker(d time.Duration) bt.ClockTicker {
+	if d <= 0 {
+		panic(errors.New(`bttest.Clock.NewTicker non-positive interval`))
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return ticker{c.start(d, d)}
+}
+
+// NewTimer implements behaviortree.Clock.NewTimer.
+func (c *Clock) NewTimer(d time.Duration) bt.ClockTimer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return timer{c.start(d, 0)}
+}
+
+// After implements behaviortree.Clock.After.
+func (c *Clock) After(d time.Duration) <-chan time.Time {
+	return c.NewTimer(d).C()
+}
+
+func (c *Clock) start(d, period time.Duration) *waiter {
+	w := &waiter{
+		clock:  c,
+		ch:     make(chan time.Time, 1),
+		period: period,
+	}
+	w.reset(d)
+	return w
+}
+
+func (c *Clock) set(now time.Time) {
+	for {
+		var next *waiter
+		for _, w := range c.waiters {
+			if !w.when.After(now) && (next == nil || w.when.Before(next.when)) {
+				next = w
+			}
+		}
+		if next == nil {
+			break
+		}
+		if next.when.After(c.now) {
+			c.now = next.when
+		}
+		next.fire()
+	}
+	c.now = now
+}
+
+// fire sends on the channel, then either schedules the next tick, or deactivates the waiter
+func (w *waiter) fire() {
+	select {
+	case w.ch <- w.when:
+	default:
+	}
+	if w.period > 0 {
+		w.when = w.when.Add(w.period)
+		return
+	}
+	w.stop()
+}
+
+// reset (re)schedules the waiter d after the current time, firing immediately if it's due, must be called with the
+// clock's mutex held
+func (w *waiter) reset(d time.Duration) (active bool) {
+	active = w.stop()
+	w.when = w.clock.now.Add(d)
+	w.active = true
+	w.clock.waiters = append(w.clock.waiters, w)
+	if d <= 0 {
+		w.fire()
+	}
+	return
+}
+
+// stop deactivates the waiter, must be called with the clock's mutex held
+func (w *waiter) stop() (active bool) {
+	if !w.active {
+		return false
+	}
+	w.active = false
+	for i, v := range w.clock.waiters {
+		if v == w {
+			w.clock.waiters = append(w.clock.waiters[:i], w.clock.waiters[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+func (t timer) C() <-chan time.Time { return t.ch }
+
+func (t timer) Reset(d time.Duration) bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	return t.reset(d)
+}
+
+func (t timer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	return t.stop()
+}
+
+func (t ticker) C() <-chan time.Time { return t.ch }
+
+func (t ticker) Reset(d time.Duration) {
+	if d <= 0 {
+		panic(errors.New(`bttest.Clock ticker non-positive interval`))
+	}
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	t.period = d
+	t.reset(d)
+}
+
+func (t ticker) Stop() {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	t.stop()
+}
@@ -0,0 +1,217 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package bttest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+)
+
+var epoch = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func receive(ch <-chan time.Time) (time.Time, bool) {
+	select {
+	case v := <-ch:
+		return v, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+func TestClock_timer(t *testing.T) {
+	c := NewClock(epoch)
+	tm := c.NewTimer(time.Second)
+	if c.Waiters() != 1 {
+		t.Fatal(c.Waiters())
+	}
+	c.Advance(time.Second - 1)
+	if _, ok := receive(tm.C()); ok {
+		t.Fatal(`unexpected fire`)
+	}
+	c.Advance(time.Hour)
+	if v, ok := receive(tm.C()); !ok || !v.Equal(epoch.Add(time.Second)) {
+		t.Fatal(v, ok)
+	}
+	if !c.Now().Equal(epoch.Add(time.Hour + time.Second - 1)) {
+		t.Error(c.Now())
+	}
+	if c.Waiters() != 0 || tm.Stop() {
+		t.Error(`expected inactive`)
+	}
+	if tm.Reset(time.Second) {
+		t.Error(`expected inactive`)
+	}
+	if !tm.Stop() {
+		t.Error(`expected active`)
+	}
+	c.Advance(time.Hour)
+	if _, ok := receive(tm.C()); ok {
+		t.Fatal(`unexpected fire`)
+	}
+	if _, ok := receive(c.After(0)); !ok {
+		t.Error(`expected immediate fire`)
+	}
+}
+
+func TestClock_ticker(t *testing.T) {
+	c := NewClock(epoch)
+	tk := c.NewTicker(time.Second)
+	c.Advance(time.Second * 3)
+	if v, ok := receive(tk.C()); !ok || !v.Equal(epoch.Add(time.Second)) {
+		t.Fatal(v, ok)
+	}
+	if _, ok := receive(tk.C()); ok {
+		t.Fatal(`expected dropped ticks`)
+	}
+	c.Advance(time.Second)
+	if v, ok := receive(tk.C()); !ok || !v.Equal(epoch.Add(time.Second*4)) {
+		t.Fatal(v, ok)
+	}
+	tk.Reset(time.Minute)
+	c.Advance(time.Second * 59)
+	if _, ok := receive(tk.C()); ok {
+		t.Fatal(`unexpected fire`)
+	}
+	c.Advance(time.Second)
+	if _, ok := receive(tk.C()); !ok {
+		t.Fatal(`expected fire`)
+	}
+	tk.Stop()
+	if c.Waiters() != 0 {
+		t.Error(c.Waiters())
+	}
+	c.Set(epoch)
+	if !c.Now().Equal(epoch) {
+		t.Error(c.Now())
+	}
+}
+
+func TestClock_order(t *testing.T) {
+	c := NewClock(epoch)
+	var (
+		a = c.NewTimer(time.Second * 2)
+		b = c.NewTimer(time.Second)
+		n []time.Time
+	)
+	c.Set(epoch.Add(time.Second * 3))
+	for _, ch := range []<-chan time.Time{b.C(), a.C()} {
+		v, ok := receive(ch)
+		if !ok {
+			t.Fatal(`expected fire`)
+		}
+		n = append(n, v)
+	}
+	if !n[0].Before(n[1]) {
+		t.Error(n)
+	}
+}
+
+func TestClock_tickerPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error(`expected panic`)
+		}
+	}()
+	NewClock(epoch).NewTicker(0)
+}
+
+func TestClock_RateLimit(t *testing.T) {
+	c := NewClock(epoch)
+	tick := bt.RateLimit(time.Second, bt.WithClock(c))
+	for i, expected := range []bt.Status{bt.Success, bt.Failure, bt.Failure} {
+		if status, err := tick(nil); err != nil || status != expected {
+			t.Fatal(i, status, err)
+		}
+		c.Advance(time.Second / 3)
+	}
+	c.Advance(time.Second / 3)
+	if status, err := tick(nil); err != nil || status != bt.Success {
+		t.Fatal(status, err)
+	}
+}
+
+func TestClock_Timeout(t *testing.T) {
+	c := NewClock(epoch)
+	tick := bt.Timeout(time.Second, func(children []bt.Node) (bt.Status, error) { return bt.Running, nil }, bt.WithClock(c))
+	if status, err := tick(nil); err != nil || status != bt.Running {
+		t.Fatal(status, err)
+	}
+	c.Advance(time.Second - 1)
+	if status, err := tick(nil); err != nil || status != bt.Running {
+		t.Fatal(status, err)
+	}
+	c.Advance(1)
+	if status, err := tick(nil); err != nil || status != bt.Failure {
+		t.Fatal(status, err)
+	}
+}
+
+func TestClock_Retry(t *testing.T) {
+	var (
+		c     = NewClock(epoch)
+		count int
+		tick  = bt.Retry(func(children []bt.Node) (bt.Status, error) {
+			count++
+			return bt.Failure, nil
+		}, bt.RetryPolicy{Retries: 1, Backoff: bt.FixedBackoff(time.Minute)}, bt.WithClock(c))
+	)
+	if status, err := tick(nil); err != nil || status != bt.Running {
+		t.Fatal(status, err)
+	}
+	c.Advance(time.Minute - 1)
+	if status, err := tick(nil); err != nil || status != bt.Running || count != 1 {
+		t.Fatal(status, err, count)
+	}
+	c.Advance(1)
+	if status, err := tick(nil); err != nil || status != bt.Failure || count != 2 {
+		t.Fatal(status, err, count)
+	}
+}
+
+func TestClock_NewTicker(t *testing.T) {
+	var (
+		c     = NewClock(epoch)
+		ticks = make(chan struct{})
+		count int
+	)
+	ticker := bt.NewTickerStopOnFailure(context.Background(), time.Second, bt.New(func(children []bt.Node) (bt.Status, error) {
+		count++
+		ticks <- struct{}{}
+		if count == 3 {
+			return bt.Failure, nil
+		}
+		return bt.Success, nil
+	}), bt.WithClock(c))
+	for i := 0; i < 3; i++ {
+		c.Advance(time.Second)
+		select {
+		case <-ticks:
+		case <-time.After(time.Second * 5):
+			t.Fatal(`expected tick`)
+		}
+	}
+	<-ticker.Done()
+	if err := ticker.Err(); err != nil {
+		t.Error(err)
+	}
+	if c.Waiters() != 0 {
+		t.Error(c.Waiters())
+	}
+}
@@ -0,0 +1,452 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package btxml provides import and export of BehaviorTree.CPP (v4) XML, e.g. as authored using Groot, for behavior
+// trees built using the behaviortree package.
+//
+// The following BehaviorTree.CPP nodes are supported, in addition to leaves provided via a Registry:
+//
+//   - Controls: Sequence and SequenceWithMemory (bt.SequenceWithMemory), Fallback (bt.SelectorWithMemory),
+//     ReactiveSequence (bt.ReactiveSequence), ReactiveFallback (bt.ReactiveSelector), Parallel (bt.Parallel, with
+//     the success_count and failure_count ports)
+//   - Decorators: Inverter (bt.Not), ForceSuccess (bt.ForceSuccess), ForceFailure (bt.ForceFailure), Repeat
+//     (bt.RepeatN, with the num_cycles port), RetryUntilSuccessful (bt.Retry, with the num_attempts port),
+//     KeepRunningUntilFailure, Timeout (bt.Timeout, with the msec port)
+//   - Leaves: AlwaysSuccess, AlwaysFailure
+//   - SubTree, with port remapping, including _autoremap, each instance of which is given it's own blackboard scope
+//     (see bt.Blackboard.Scope)
+//
+// Note that the semantics of the BehaviorTree.CPP nodes are approximated, e.g. SequenceWithMemory will restart on
+// failure, and that the TreeNodesModel element is ignored.
+package btxml
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+)
+
+type (
+	// Registry maps BehaviorTree.CPP node IDs to leaf implementations, and may be used to load trees, see
+	// Registry.Load. It is safe for concurrent use.
+	Registry struct {
+		mutex  sync.RWMutex
+		leaves map[string]LeafFactory
+	}
+
+	// LeafFactory builds the tick for a leaf (action or condition) node, with the given ports, see Input and Output
+	LeafFactory func(ports Ports) (bt.Tick, error)
+
+	// vkElement is the value key for the element a node was loaded from, used by Export
+	vkElement struct{}
+
+	// vkTreeID is the value key for the ID of the BehaviorTree a node is the root of, used by Export
+	vkTreeID struct{}
+
+	// loader holds the state for a single Registry.Load call
+	loader struct {
+		registry *Registry
+		filename string
+		trees    map[string]*element
+		building map[string]bool
+	}
+)
+
+var (
+	controls = map[string]func(ports Ports) (bt.Tick, error){
+		`Sequence`:           func(Ports) (bt.Tick, error) { return bt.SequenceWithMemory(), nil },
+		`SequenceWithMemory`: func(Ports) (bt.Tick, error) { return bt.SequenceWithMemory(), nil },
+		`Fallback`:           func(Ports) (bt.Tick, error) { return bt.SelectorWithMemory(), nil },
+		`ReactiveSequence`:   func(Ports) (bt.Tick, error) { return bt.ReactiveSequence(), nil },
+		`ReactiveFallback`:   func(Ports) (bt.Tick, error) { return bt.ReactiveSelector(), nil },
+		`Parallel`: func(ports Ports) (bt.Tick, error) {
+			success, err := inputOrDefault(ports, `success_count`, bt.SuccessOnAll)
+			if err != nil {
+				return nil, err
+			}
+			failure, err := inputOrDefault(ports, `failure_count`, bt.FailOnOne)
+			if err != nil {
+				return nil, err
+			}
+			return bt.Parallel(success, failure), nil
+		},
+	}
+
+	decorators = map[string]func(ports Ports, tick bt.Tick) (bt.Tick, error){
+		`Inverter`:                func(_ Ports, tick bt.Tick) (bt.Tick, error) { return bt.Not(tick), nil },
+		`ForceSuccess`:            func(_ Ports, tick bt.Tick) (bt.Tick, error) { return bt.ForceSuccess(tick), nil },
+		`ForceFailure`:            func(_ Ports, tick bt.Tick) (bt.Tick, error) { return bt.ForceFailure(tick), nil },
+		`KeepRunningUntilFailure`: func(_ Ports, tick bt.Tick) (bt.Tick, error) { return keepRunningUntilFailure(tick), nil },
+		`Repeat`: func(ports Ports, tick bt.Tick) (bt.Tick, error) {
+			n, err := Input[int](ports, `num_cycles`)
+			if err != nil {
+				return nil, err
+			}
+			return bt.RepeatN(n, tick), nil
+		},
+		`RetryUntilSuccessful`: func(ports Ports, tick bt.Tick) (bt.Tick, error) {
+			n, err := Input[int](ports, `num_attempts`)
+			if err != nil {
+				return nil, err
+			}
+			retries := n - 1
+			if n < 0 {
+				retries = -1
+			}
+			return bt.Retry(tick, bt.RetryPolicy{Retries: retries}), nil
+		},
+		`Timeout`: func(ports Ports, tick bt.Tick) (bt.Tick, error) {
+			msec, err := Input[int](ports, `msec`)
+			if err != nil {
+				return nil, err
+			}
+			return bt.Timeout(time.Duration(msec)*time.Millisecond, tick), nil
+		},
+	}
+
+	leaves = map[string]bt.Tick{
+		`AlwaysSuccess`: func([]bt.Node) (bt.Status, error) { return bt.Success, nil },
+		`AlwaysFailure`: func([]bt.Node) (bt.Status, error) { return bt.Failure, nil },
+	}
+
+	// explicit are the element names which use the ID attribute to identify the node
+	explicit = map[string]bool{
+		`Action`:    true,
+		`Condition`: true,
+		`Control`:   true,
+		`Decorator`: true,
+	}
+)
+
+// NewRegistry constructs a new, empty, Registry.
+func NewRegistry() *Registry {
+	return new(Registry)
+}
+
+// Register registers the leaf implementation for the given node ID, which will be called for each instance of the
+// node. A panic will occur if the receiver or factory are nil, or if the ID is empty, or is already registered, or is
+// built-in.
+func (r *Registry) Register(id string, factory LeafFactory) {
+	if r == nil {
+		panic(errors.New(`btxml.Registry.Register nil receiver`))
+	}
+	if factory == nil {
+		panic(errors.New(`btxml.Registry.Register nil factory`))
+	}
+	if id == `` {
+		panic(errors.New(`btxml.Registry.Register empty id`))
+	}
+	if isBuiltin(id) {
+		panic(errors.New(`btxml.Registry.Register builtin id: ` + id))
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if _, ok := r.leaves[id]; ok {
+		panic(errors.New(`btxml.Registry.Register duplicate id: ` + id))
+	}
+	if r.leaves == nil {
+		r.leaves = make(map[string]LeafFactory)
+	}
+	r.leaves[id] = factory
+}
+
+// LoadFile reads then loads the given file, see Registry.Load.
+func (r *Registry) LoadFile(filename string, blackboard *bt.Blackboard) (bt.Node, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return r.Load(filename, data, blackboard)
+}
+
+// Load builds the main tree (per the main_tree_to_execute attribute, or the only tree) from a BehaviorTree.CPP XML
+// document, using blackboard (which will be attached to the result, see bt.Node.WithBlackboard) for any ports.
+//
+// Each node will have the name attached (see bt.Node.WithName), defaulting to the ID, as well as a frame pointing to
+// the element's location in the document (see bt.Node.WithFrame), with the function set to the ID, and the file set
+// to filename, which is otherwise only used for errors. A panic will occur if blackboard is nil.
+func (r *Registry) Load(filename string, data []byte, blackboard *bt.Blackboard) (bt.Node, error) {
+	if blackboard == nil {
+		panic(errors.New(`btxml.Registry.Load nil blackboard`))
+	}
+	root, err := parse(data)
+	if err != nil {
+		return nil, fmt.Errorf(`btxml.Registry.Load %s: %w`, filename, err)
+	}
+	l := loader{
+		registry: r,
+		filename: filename,
+		trees:    make(map[string]*element),
+		building: make(map[string]bool),
+	}
+	if root.name != `root` {
+		return nil, l.errorf(root, `expected root element`)
+	}
+	if v, ok := root.attr(`BTCPP_format`); ok && v != `4` {
+		return nil, l.errorf(root, `unsupported BTCPP_format %q`, v)
+	}
+	var first string
+	for _, tree := range root.children {
+		if tree.name != `BehaviorTree` {
+			continue
+		}
+		id, _ := tree.attr(`ID`)
+		if id == `` {
+			return nil, l.errorf(tree, `missing ID`)
+		}
+		if l.trees[id] != nil {
+			return nil, l.errorf(tree, `duplicate BehaviorTree %q`, id)
+		}
+		if len(tree.children) != 1 {
+			return nil, l.errorf(tree, `BehaviorTree %q must have exactly one child`, id)
+		}
+		l.trees[id] = tree
+		if first == `` {
+			first = id
+		}
+	}
+	main, _ := root.attr(`main_tree_to_execute`)
+	if main == `` {
+		if len(l.trees) != 1 {
+			return nil, l.errorf(root, `main_tree_to_execute is required`)
+		}
+		main = first
+	}
+	node, err := l.tree(root, main, blackboard)
+	if err != nil {
+		return nil, err
+	}
+	return node.WithBlackboard(blackboard), nil
+}
+
+func (l *loader) errorf(e *element, format string, args ...any) error {
+	return fmt.Errorf(`btxml.Registry.Load %s:%d: %s`, l.filename, e.line, fmt.Sprintf(format, args...))
+}
+
+// tree builds the BehaviorTree with the given ID
+func (l *loader) tree(ref *element, id string, blackboard *bt.Blackboard) (bt.Node, error) {
+	tree := l.trees[id]
+	if tree == nil {
+		return nil, l.errorf(ref, `unknown BehaviorTree %q`, id)
+	}
+	if l.building[id] {
+		return nil, l.errorf(ref, `recursive BehaviorTree %q`, id)
+	}
+	l.building[id] = true
+	defer delete(l.building, id)
+	node, err := l.node(tree.children[0], blackboard)
+	if err != nil {
+		return nil, err
+	}
+	return node.WithValue(vkTreeID{}, id), nil
+}
+
+// node builds the node for the given element
+func (l *loader) node(e *element, blackboard *bt.Blackboard) (bt.Node, error) {
+	id := e.name
+	if explicit[id] {
+		id, _ = e.attr(`ID`)
+		if id == `` {
+			return nil, l.errorf(e, `missing ID`)
+		}
+	}
+	ports := Ports{blackboard: blackboard, values: e.ports()}
+
+	var (
+		tick     bt.Tick
+		children []bt.Node
+		err      error
+	)
+	switch {
+	case e.name == `SubTree`:
+		if len(e.children) != 0 {
+			return nil, l.errorf(e, `SubTree must not have children`)
+		}
+		if id, _ = e.attr(`ID`); id == `` {
+			return nil, l.errorf(e, `missing ID`)
+		}
+		var child bt.Node
+		if child, err = l.subtree(e, id, blackboard); err != nil {
+			return nil, err
+		}
+		tick, children = passThrough, []bt.Node{child}
+
+	case controls[id] != nil:
+		if tick, err = controls[id](ports); err != nil {
+			break
+		}
+		for _, c := range e.children {
+			child, err := l.node(c, blackboard)
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, child)
+		}
+
+	case decorators[id] != nil:
+		if len(e.children) != 1 {
+			return nil, l.errorf(e, `%s must have exactly one child`, id)
+		}
+		var child bt.Node
+		if child, err = l.node(e.children[0], blackboard); err != nil {
+			return nil, err
+		}
+		tick, err = decorators[id](ports, passThrough)
+		children = []bt.Node{child}
+
+	default:
+		if len(e.children) != 0 {
+			return nil, l.errorf(e, `unknown %s %q with children`, e.name, id)
+		}
+		if tick = leaves[id]; tick == nil {
+			l.registry.mutex.RLock()
+			factory := l.registry.leaves[id]
+			l.registry.mutex.RUnlock()
+			if factory == nil {
+				return nil, l.errorf(e, `unknown node %q`, id)
+			}
+			tick, err = factory(ports)
+		}
+	}
+	if err != nil {
+		return nil, l.errorf(e, `%s: %s`, id, err)
+	}
+	if tick == nil {
+		return nil, l.errorf(e, `%s: nil tick`, id)
+	}
+
+	name, _ := e.attr(`name`)
+	if name == `` {
+		name = id
+	}
+	return bt.New(tick, children...).
+		WithValue(vkElement{}, e.clone()).
+		WithName(name).
+		WithFrame(&bt.Frame{Function: id, File: l.filename, Line: e.line}), nil
+}
+
+// subtree builds an instance of the BehaviorTree with the given ID, referenced by the SubTree element e, using a new
+// scope of blackboard, remapped per the ports of e
+func (l *loader) subtree(e *element, id string, blackboard *bt.Blackboard) (bt.Node, error) {
+	var (
+		remap    = make(map[string]string)
+		literals = make(map[string]string)
+	)
+	if v, _ := e.attr(`_autoremap`); v == `true` || v == `1` {
+		if l.trees[id] != nil {
+			for key := range l.references(id, make(map[string]bool)) {
+				remap[key] = key
+			}
+		}
+	}
+	for name, value := range e.ports() {
+		if name == `_autoremap` {
+			continue
+		}
+		if key, ok := parseReference(name, value); ok {
+			remap[name] = key
+		} else {
+			delete(remap, name)
+			literals[name] = value
+		}
+	}
+	scope := blackboard.Scope(remap)
+	for name, value := range literals {
+		bt.NewKey[string](name).Set(scope, value)
+	}
+	node, err := l.tree(e, id, scope)
+	if err != nil {
+		return nil, err
+	}
+	return node.WithBlackboard(scope), nil
+}
+
+// references returns the blackboard keys referenced by the BehaviorTree with the given ID, including those of any
+// subtrees that are automatically remapped
+func (l *loader) references(id string, visited map[string]bool) map[string]bool {
+	keys := make(map[string]bool)
+	if visited[id] || l.trees[id] == nil {
+		return keys
+	}
+	visited[id] = true
+	var visit func(e *element)
+	visit = func(e *element) {
+		for name, value := range e.ports() {
+			if key, ok := parseReference(name, value); ok {
+				keys[key] = true
+			}
+		}
+		if e.name == `SubTree` {
+			if v, _ := e.attr(`_autoremap`); v == `true` || v == `1` {
+				sub, _ := e.attr(`ID`)
+				for key := range l.references(sub, visited) {
+					keys[key] = true
+				}
+			}
+		}
+		for _, child := range e.children {
+			visit(child)
+		}
+	}
+	visit(l.trees[id].children[0])
+	return keys
+}
+
+func isBuiltin(id string) bool {
+	return id == `SubTree` || explicit[id] || controls[id] != nil || decorators[id] != nil || leaves[id] != nil
+}
+
+func inputOrDefault(ports Ports, name string, value int) (int, error) {
+	if _, ok := ports.Raw(name); !ok {
+		return value, nil
+	}
+	return Input[int](ports, name)
+}
+
+// passThrough ticks the only child, and is used to implement decorators and subtrees, which don't collapse their
+// child, facilitating export
+func passThrough(children []bt.Node) (bt.Status, error) {
+	return children[0].Tick()
+}
+
+// keepRunningUntilFailure implements KeepRunningUntilFailure
+func keepRunningUntilFailure(tick bt.Tick) bt.Tick {
+	return bt.Tick(func(children []bt.Node) (bt.Status, error) {
+		status, err := tick(children)
+		if err != nil || status == bt.Failure {
+			return bt.Failure, err
+		}
+		return bt.Running, nil
+	}).WithHalt(tick.Halt)
+}
+
+// parseReference returns the blackboard key, if value is a reference, i.e. "{key}", where "{=}" refers to the key
+// with the same name as the port
+func parseReference(name, value string) (string, bool) {
+	if len(value) < 3 || !strings.HasPrefix(value, `{`) || !strings.HasSuffix(value, `}`) {
+		return ``, false
+	}
+	key := value[1 : len(value)-1]
+	if key == `=` {
+		key = name
+	}
+	return key, true
+}
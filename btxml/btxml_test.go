@@ -0,0 +1,385 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package btxml
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	bt "github.com/joeycumines/go-behaviortree"
+)
+
+const testDocument = `<?xml version="1.0"?>
+<root BTCPP_format="4" main_tree_to_execute="MainTree">
+  <BehaviorTree ID="MainTree">
+    <Sequence name="main">
+      <SetGoal goal="{target}" value="kitchen"/>
+      <SubTree ID="MoveTo" destination="{target}" speed="2" _autoremap="true"/>
+      <Fallback>
+        <Inverter>
+          <Condition ID="Say" message="{said}"/>
+        </Inverter>
+        <Action ID="Say" message="done"/>
+      </Fallback>
+    </Sequence>
+  </BehaviorTree>
+  <BehaviorTree ID="MoveTo">
+    <ReactiveSequence>
+      <Say message="{destination}"/>
+      <Say message="{speed}"/>
+      <SubTree ID="Report" _autoremap="true"/>
+    </ReactiveSequence>
+  </BehaviorTree>
+  <BehaviorTree ID="Report">
+    <ForceSuccess>
+      <Record said="{said}"/>
+    </ForceSuccess>
+  </BehaviorTree>
+  <TreeNodesModel>
+    <Action ID="Say"><input_port name="message"/></Action>
+  </TreeNodesModel>
+</root>
+`
+
+func newTestRegistry(events *[]string) *Registry {
+	r := NewRegistry()
+	r.Register(`SetGoal`, func(ports Ports) (bt.Tick, error) {
+		return func([]bt.Node) (bt.Status, error) {
+			value, err := Input[string](ports, `value`)
+			if err != nil {
+				return bt.Failure, err
+			}
+			return bt.Success, Output(ports, `goal`, value)
+		}, nil
+	})
+	r.Register(`Say`, func(ports Ports) (bt.Tick, error) {
+		return func([]bt.Node) (bt.Status, error) {
+			message, err := Input[string](ports, `message`)
+			if err != nil {
+				return bt.Failure, err
+			}
+			*events = append(*events, message)
+			return bt.Success, nil
+		}, nil
+	})
+	r.Register(`Record`, func(ports Ports) (bt.Tick, error) {
+		return func([]bt.Node) (bt.Status, error) {
+			*events = append(*events, `record`)
+			return bt.Failure, Output(ports, `said`, `hello`)
+		}, nil
+	})
+	return r
+}
+
+func TestRegistry_Load(t *testing.T) {
+	var (
+		events     []string
+		blackboard = bt.NewBlackboard()
+	)
+	node, err := newTestRegistry(&events).Load(`tree.xml`, []byte(testDocument), blackboard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node.Blackboard() != blackboard {
+		t.Error(node.Blackboard())
+	}
+	if v := node.Name(); v != `main` {
+		t.Error(v)
+	}
+	if v := node.Frame(); v == nil || *v != (bt.Frame{Function: `Sequence`, File: `tree.xml`, Line: 4}) {
+		t.Error(v)
+	}
+	if status, err := node.Tick(); err != nil || status != bt.Success {
+		t.Fatal(status, err)
+	}
+	if !reflect.DeepEqual(events, []string{`kitchen`, `2`, `record`, `hello`, `done`}) {
+		t.Error(events)
+	}
+	if v, _ := bt.NewKey[string](`target`).Get(blackboard); v != `kitchen` {
+		t.Error(v)
+	}
+	if v, _ := bt.NewKey[string](`said`).Get(blackboard); v != `hello` {
+		t.Error(v)
+	}
+	blackboard.Range(func(name string, value any) bool {
+		if name != `target` && name != `said` {
+			t.Error(name, value)
+		}
+		return true
+	})
+}
+
+func TestRegistry_Load_preempted(t *testing.T) {
+	var (
+		events   []string
+		statuses = map[string]bt.Status{`cond`: bt.Success, `step1`: bt.Success, `step2`: bt.Running}
+		r        = NewRegistry()
+	)
+	r.Register(`Step`, func(ports Ports) (bt.Tick, error) {
+		id, err := Input[string](ports, `id`)
+		if err != nil {
+			return nil, err
+		}
+		return func([]bt.Node) (bt.Status, error) {
+			events = append(events, id)
+			return statuses[id], nil
+		}, nil
+	})
+	node, err := r.Load(``, []byte(`<root BTCPP_format="4">
+  <BehaviorTree ID="MainTree">
+    <ReactiveSequence>
+      <Step id="cond"/>
+      <Sequence>
+        <Step id="step1"/>
+        <Step id="step2"/>
+      </Sequence>
+    </ReactiveSequence>
+  </BehaviorTree>
+</root>`), bt.NewBlackboard())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		Cond   bt.Status
+		Status bt.Status
+		Events []string
+	}{
+		{bt.Success, bt.Running, []string{`cond`, `step1`, `step2`}},
+		{bt.Success, bt.Running, []string{`cond`, `step2`}},
+		{bt.Failure, bt.Failure, []string{`cond`}},
+		{bt.Success, bt.Running, []string{`cond`, `step1`, `step2`}},
+	} {
+		events = nil
+		statuses[`cond`] = tc.Cond
+		if status, err := node.Tick(); err != nil || status != tc.Status {
+			t.Fatal(status, err)
+		}
+		if !reflect.DeepEqual(events, tc.Events) {
+			t.Error(events)
+		}
+	}
+}
+
+func TestRegistry_Load_builtins(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Tree     string
+		Expected []bt.Status
+	}{
+		{`parallel`, `<Parallel success_count="1" failure_count="2"><AlwaysFailure/><AlwaysSuccess/></Parallel>`, []bt.Status{bt.Success}},
+		{`parallel defaults`, `<Parallel><AlwaysSuccess/><AlwaysFailure/></Parallel>`, []bt.Status{bt.Failure}},
+		{`reactive fallback`, `<ReactiveFallback><AlwaysFailure/><AlwaysSuccess/></ReactiveFallback>`, []bt.Status{bt.Success}},
+		{`sequence with memory`, `<SequenceWithMemory><AlwaysSuccess/></SequenceWithMemory>`, []bt.Status{bt.Success}},
+		{`fallback`, `<Fallback><AlwaysFailure/><AlwaysFailure/></Fallback>`, []bt.Status{bt.Failure}},
+		{`force failure`, `<ForceFailure><AlwaysSuccess/></ForceFailure>`, []bt.Status{bt.Failure}},
+		{`repeat`, `<Repeat num_cycles="2"><AlwaysSuccess/></Repeat>`, []bt.Status{bt.Running, bt.Success}},
+		{`retry`, `<RetryUntilSuccessful num_attempts="2"><AlwaysFailure/></RetryUntilSuccessful>`, []bt.Status{bt.Running, bt.Failure}},
+		{`keep running`, `<KeepRunningUntilFailure><AlwaysSuccess/></KeepRunningUntilFailure>`, []bt.Status{bt.Running, bt.Running}},
+		{`timeout`, `<Timeout msec="1000"><AlwaysSuccess/></Timeout>`, []bt.Status{bt.Success}},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			node, err := NewRegistry().Load(``, []byte(`<root><BehaviorTree ID="a">`+tc.Tree+`</BehaviorTree></root>`), bt.NewBlackboard())
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, expected := range tc.Expected {
+				if status, err := node.Tick(); err != nil || status != expected {
+					t.Error(status, err)
+				}
+			}
+		})
+	}
+}
+
+func TestRegistry_Load_errors(t *testing.T) {
+	var events []string
+	r := newTestRegistry(&events)
+	for _, tc := range []struct {
+		Name string
+		Data string
+		Err  string
+	}{
+		{`invalid`, `<root>`, `btxml.Registry.Load f: XML syntax error on line 1: unexpected EOF`},
+		{`empty`, ``, `btxml.Registry.Load f: empty document`},
+		{`not root`, `<tree/>`, `btxml.Registry.Load f:1: expected root element`},
+		{`format`, `<root BTCPP_format="3"/>`, `btxml.Registry.Load f:1: unsupported BTCPP_format "3"`},
+		{`no main`, `<root/>`, `btxml.Registry.Load f:1: main_tree_to_execute is required`},
+		{`missing tree ID`, `<root><BehaviorTree/></root>`, `btxml.Registry.Load f:1: missing ID`},
+		{`duplicate tree`, "<root>\n<BehaviorTree ID=\"a\"><AlwaysSuccess/></BehaviorTree>\n<BehaviorTree ID=\"a\"/></root>", `btxml.Registry.Load f:3: duplicate BehaviorTree "a"`},
+		{`tree children`, `<root><BehaviorTree ID="a"/></root>`, `btxml.Registry.Load f:1: BehaviorTree "a" must have exactly one child`},
+		{`unknown main`, `<root main_tree_to_execute="b"><BehaviorTree ID="a"><AlwaysSuccess/></BehaviorTree></root>`, `btxml.Registry.Load f:1: unknown BehaviorTree "b"`},
+		{`unknown node`, "<root><BehaviorTree ID=\"a\">\n<Nope/></BehaviorTree></root>", `btxml.Registry.Load f:2: unknown node "Nope"`},
+		{`unknown composite`, `<root><BehaviorTree ID="a"><Nope><Say/></Nope></BehaviorTree></root>`, `btxml.Registry.Load f:1: unknown Nope "Nope" with children`},
+		{`missing action ID`, `<root><BehaviorTree ID="a"><Action/></BehaviorTree></root>`, `btxml.Registry.Load f:1: missing ID`},
+		{`decorator`, `<root><BehaviorTree ID="a"><Inverter/></BehaviorTree></root>`, `btxml.Registry.Load f:1: Inverter must have exactly one child`},
+		{`decorator port`, `<root><BehaviorTree ID="a"><Repeat><Say/></Repeat></BehaviorTree></root>`, `btxml.Registry.Load f:1: Repeat: btxml: port not found: num_cycles`},
+		{`control port`, `<root><BehaviorTree ID="a"><Parallel failure_count="x"/></BehaviorTree></root>`, `btxml.Registry.Load f:1: Parallel: btxml: port failure_count: expected integer`},
+		{`subtree children`, `<root><BehaviorTree ID="a"><SubTree ID="a"><Say/></SubTree></BehaviorTree></root>`, `btxml.Registry.Load f:1: SubTree must not have children`},
+		{`subtree ID`, `<root><BehaviorTree ID="a"><SubTree/></BehaviorTree></root>`, `btxml.Registry.Load f:1: missing ID`},
+		{`recursive`, `<root><BehaviorTree ID="a"><SubTree ID="a" _autoremap="true"/></BehaviorTree></root>`, `btxml.Registry.Load f:1: recursive BehaviorTree "a"`},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			if node, err := r.Load(`f`, []byte(tc.Data), bt.NewBlackboard()); node != nil || err == nil || err.Error() != tc.Err {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestRegistry_LoadFile(t *testing.T) {
+	var events []string
+	filename := filepath.Join(t.TempDir(), `tree.xml`)
+	if err := os.WriteFile(filename, []byte(testDocument), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	node, err := newTestRegistry(&events).LoadFile(filename, bt.NewBlackboard())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := node.Frame(); v == nil || v.File != filename {
+		t.Error(v)
+	}
+	if _, err := NewRegistry().LoadFile(filename+`.missing`, bt.NewBlackboard()); !errors.Is(err, os.ErrNotExist) {
+		t.Error(err)
+	}
+}
+
+func TestRegistry_Register_panic(t *testing.T) {
+	factory := func(Ports) (bt.Tick, error) { return nil, nil }
+	for _, tc := range []struct {
+		Panic string
+		Fn    func()
+	}{
+		{`btxml.Registry.Register nil receiver`, func() { (*Registry)(nil).Register(`a`, factory) }},
+		{`btxml.Registry.Register nil factory`, func() { NewRegistry().Register(`a`, nil) }},
+		{`btxml.Registry.Register empty id`, func() { NewRegistry().Register(``, factory) }},
+		{`btxml.Registry.Register builtin id: Fallback`, func() { NewRegistry().Register(`Fallback`, factory) }},
+		{`btxml.Registry.Register duplicate id: a`, func() {
+			r := NewRegistry()
+			r.Register(`a`, factory)
+			r.Register(`a`, factory)
+		}},
+		{`btxml.Registry.Load nil blackboard`, func() { _, _ = NewRegistry().Load(``, nil, nil) }},
+	} {
+		t.Run(tc.Panic, func(t *testing.T) {
+			defer func() {
+				if s := fmt.Sprint(recover()); s != tc.Panic {
+					t.Error(s)
+				}
+			}()
+			tc.Fn()
+			t.Error(`expected a panic`)
+		})
+	}
+}
+
+func TestExport_roundTrip(t *testing.T) {
+	var events []string
+	r := newTestRegistry(&events)
+	node, err := r.Load(`tree.xml`, []byte(testDocument), bt.NewBlackboard())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	if err := Export(&b, node); err != nil {
+		t.Fatal(err)
+	}
+	if v := b.String(); v != `<root BTCPP_format="4" main_tree_to_execute="MainTree">
+  <BehaviorTree ID="MainTree">
+    <Sequence name="main">
+      <SetGoal goal="{target}" value="kitchen"></SetGoal>
+      <SubTree ID="MoveTo" destination="{target}" speed="2" _autoremap="true"></SubTree>
+      <Fallback>
+        <Inverter>
+          <Condition ID="Say" message="{said}"></Condition>
+        </Inverter>
+        <Action ID="Say" message="done"></Action>
+      </Fallback>
+    </Sequence>
+  </BehaviorTree>
+  <BehaviorTree ID="MoveTo">
+    <ReactiveSequence>
+      <Say message="{destination}"></Say>
+      <Say message="{speed}"></Say>
+      <SubTree ID="Report" _autoremap="true"></SubTree>
+    </ReactiveSequence>
+  </BehaviorTree>
+  <BehaviorTree ID="Report">
+    <ForceSuccess>
+      <Record said="{said}"></Record>
+    </ForceSuccess>
+  </BehaviorTree>
+</root>
+` {
+		t.Error(v)
+	}
+	if _, err := r.Load(``, b.Bytes(), bt.NewBlackboard()); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestExport_inferred(t *testing.T) {
+	leaf := bt.New(func([]bt.Node) (bt.Status, error) { return bt.Success, nil })
+	node := bt.New(
+		bt.Selector,
+		bt.New(bt.Sequence, leaf.WithName(`Check`)).WithName(`guard`),
+		bt.New(bt.Parallel(bt.SuccessOnAll, bt.FailOnOne), leaf),
+		bt.New(bt.Memorize(bt.Sequence), leaf.WithName(`Act`)).WithName(`Custom`),
+		bt.New(bt.SequenceWithMemory(), bt.New(bt.ReactiveSelector(), leaf.WithName(`Act`))),
+	)
+	var b bytes.Buffer
+	if err := Export(&b, node); err != nil {
+		t.Fatal(err)
+	}
+	if v := b.String(); v != `<root BTCPP_format="4" main_tree_to_execute="MainTree">
+  <BehaviorTree ID="MainTree">
+    <ReactiveFallback>
+      <ReactiveSequence name="guard">
+        <Action ID="Check"></Action>
+      </ReactiveSequence>
+      <Parallel>
+        <Action ID="btxml.TestExport_inferred"></Action>
+      </Parallel>
+      <Control ID="Custom">
+        <Action ID="Act"></Action>
+      </Control>
+      <Sequence>
+        <ReactiveFallback>
+          <Action ID="Act"></Action>
+        </ReactiveFallback>
+      </Sequence>
+    </ReactiveFallback>
+  </BehaviorTree>
+</root>
+` {
+		t.Error(v)
+	}
+}
+
+func TestExport_nil(t *testing.T) {
+	if err := Export(new(bytes.Buffer), nil); err == nil || err.Error() != `btxml.Export nil node` {
+		t.Error(err)
+	}
+	if err := Export(new(bytes.Buffer), bt.New(bt.Sequence, nil)); err == nil || err.Error() != `btxml.Export nil node` {
+		t.Error(err)
+	}
+}
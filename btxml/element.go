@@ -0,0 +1,96 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package btxml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+)
+
+// element is a parsed XML element, retaining the (ordered) attributes and line number
+type element struct {
+	name     string
+	attrs    []xml.Attr
+	children []*element
+	line     int
+}
+
+// parse decodes the root element of an XML document, ignoring anything other than elements
+func parse(data []byte) (*element, error) {
+	var (
+		decoder = xml.NewDecoder(bytes.NewReader(data))
+		stack   []*element
+		root    *element
+	)
+	for {
+		line, _ := decoder.InputPos()
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			e := &element{name: token.Name.Local, attrs: token.Copy().Attr, line: line}
+			if len(stack) != 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, e)
+			} else if root == nil {
+				root = e
+			}
+			stack = append(stack, e)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+	if root == nil {
+		return nil, errors.New(`empty document`)
+	}
+	return root, nil
+}
+
+// attr returns the value of the attribute with the given (local) name
+func (e *element) attr(name string) (string, bool) {
+	for _, a := range e.attrs {
+		if a.Name.Local == name {
+			return a.Value, true
+		}
+	}
+	return ``, false
+}
+
+// ports returns the attributes of the element, excluding those that aren't ports
+func (e *element) ports() map[string]string {
+	ports := make(map[string]string, len(e.attrs))
+	for _, a := range e.attrs {
+		switch a.Name.Local {
+		case `ID`, `name`:
+		default:
+			ports[a.Name.Local] = a.Value
+		}
+	}
+	return ports
+}
+
+// clone returns a copy of the element, without any children, as attached to loaded nodes
+func (e *element) clone() *element {
+	return &element{name: e.name, attrs: append([]xml.Attr(nil), e.attrs...), line: e.line}
+}
@@ -0,0 +1,184 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package btxml
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+
+	bt "github.com/joeycumines/go-behaviortree"
+)
+
+type (
+	// exporter holds the state for a single Export call
+	exporter struct {
+		encoder *xml.Encoder
+		queue   []exportTree
+		queued  map[string]bool
+	}
+
+	exportTree struct {
+		id   string
+		root bt.Metadata
+	}
+)
+
+var (
+	// exportKinds maps ticks provided by the behaviortree package (by kind, see bt.Tick.Kind) to elements
+	exportKinds = map[string]string{
+		`Sequence`:           `ReactiveSequence`,
+		`Selector`:           `ReactiveFallback`,
+		`ReactiveSequence`:   `ReactiveSequence`,
+		`ReactiveSelector`:   `ReactiveFallback`,
+		`SequenceWithMemory`: `Sequence`,
+		`SelectorWithMemory`: `Fallback`,
+		`Parallel`:           `Parallel`,
+	}
+)
+
+// Export writes the logical structure (see bt.Walk) of the tree as a BehaviorTree.CPP (v4) XML document, the reverse
+// of Registry.Load, with each SubTree written as a separate BehaviorTree.
+//
+// Nodes loaded using this package are written as they were loaded, including their ports. Other nodes are written on a
+// best-effort basis, where bt.Sequence, bt.Selector, their reactive and memory variants (e.g. bt.SequenceWithMemory),
+// and bt.Parallel (without ports) are supported, and any other node is written as an Action, or Control (if it has
+// children), with the ID being the name (see bt.GetName), or function of the frame. The ID of the main tree is retained
+// if it was loaded, and is otherwise "MainTree".
+func Export(output io.Writer, node bt.Node) error {
+	if node == nil {
+		return errors.New(`btxml.Export nil node`)
+	}
+	id, _ := node.Value(vkTreeID{}).(string)
+	if id == `` {
+		id = `MainTree`
+	}
+	x := exporter{
+		encoder: xml.NewEncoder(output),
+		queued:  map[string]bool{id: true},
+		queue:   []exportTree{{id: id, root: node}},
+	}
+	x.encoder.Indent(``, `  `)
+	root := xml.StartElement{Name: xml.Name{Local: `root`}, Attr: []xml.Attr{
+		{Name: xml.Name{Local: `BTCPP_format`}, Value: `4`},
+		{Name: xml.Name{Local: `main_tree_to_execute`}, Value: id},
+	}}
+	if err := x.encoder.EncodeToken(root); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.queue); i++ {
+		tree := xml.StartElement{Name: xml.Name{Local: `BehaviorTree`}, Attr: []xml.Attr{
+			{Name: xml.Name{Local: `ID`}, Value: x.queue[i].id},
+		}}
+		if err := x.encoder.EncodeToken(tree); err != nil {
+			return err
+		}
+		if err := x.node(x.queue[i].root); err != nil {
+			return err
+		}
+		if err := x.encoder.EncodeToken(tree.End()); err != nil {
+			return err
+		}
+	}
+	if err := x.encoder.EncodeToken(root.End()); err != nil {
+		return err
+	}
+	if err := x.encoder.Flush(); err != nil {
+		return err
+	}
+	_, err := io.WriteString(output, "\n")
+	return err
+}
+
+func (x *exporter) node(n bt.Metadata) error {
+	if node, ok := n.(bt.Node); n == nil || (ok && node == nil) {
+		return errors.New(`btxml.Export nil node`)
+	}
+
+	var children []bt.Metadata
+	n.Children(func(child bt.Metadata) bool {
+		children = append(children, child)
+		return true
+	})
+
+	var start xml.StartElement
+	if e, _ := n.Value(vkElement{}).(*element); e != nil {
+		start = xml.StartElement{Name: xml.Name{Local: e.name}, Attr: e.attrs}
+		if e.name == `SubTree` {
+			id, _ := e.attr(`ID`)
+			if len(children) != 1 {
+				return errors.New(`btxml.Export SubTree must have exactly one child: ` + id)
+			}
+			if !x.queued[id] {
+				x.queued[id] = true
+				x.queue = append(x.queue, exportTree{id: id, root: children[0]})
+			}
+			children = nil
+		}
+	} else {
+		start = exportElement(n, len(children) != 0)
+	}
+
+	if err := x.encoder.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, child := range children {
+		if err := x.node(child); err != nil {
+			return err
+		}
+	}
+	return x.encoder.EncodeToken(start.End())
+}
+
+// exportElement infers the element for a node that wasn't loaded using this package
+func exportElement(n bt.Metadata, composite bool) xml.StartElement {
+	name := bt.GetName(n)
+	if node, ok := n.(bt.Node); ok {
+		tick, _ := node()
+		if tag := exportKinds[tick.Kind()]; tag != `` {
+			start := xml.StartElement{Name: xml.Name{Local: tag}}
+			if name != `` {
+				start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: `name`}, Value: name})
+			}
+			return start
+		}
+	}
+	id := name
+	if id == `` {
+		var frame *bt.Frame
+		if node, ok := n.(bt.Node); ok {
+			frame = node.Frame()
+		} else {
+			frame = bt.GetFrame(n)
+		}
+		if frame != nil {
+			id = frame.Function
+			if i := strings.LastIndex(id, `/`); i >= 0 {
+				id = id[i+1:]
+			}
+		}
+	}
+	if id == `` {
+		id = `-`
+	}
+	tag := `Action`
+	if composite {
+		tag = `Control`
+	}
+	return xml.StartElement{Name: xml.Name{Local: tag}, Attr: []xml.Attr{{Name: xml.Name{Local: `ID`}, Value: id}}}
+}
@@ -0,0 +1,116 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package btxml
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+)
+
+// Ports provides access to the ports of a node, which are either literal values, or references to keys of the
+// blackboard (scope) of the node, e.g. "{target}", or "{=}" (for the key with the same name as the port), see Input
+// and Output.
+type Ports struct {
+	blackboard *bt.Blackboard
+	values     map[string]string
+}
+
+var (
+	// ErrPortNotFound is returned by Input and Output if the port is not present
+	ErrPortNotFound = errors.New(`btxml: port not found`)
+
+	// ErrPortNotReference is returned by Output if the port is not a reference to a blackboard key
+	ErrPortNotReference = errors.New(`btxml: port is not a blackboard reference`)
+
+	// ErrPortValueNotFound is returned by Input if the port references a blackboard key that has no value
+	ErrPortValueNotFound = errors.New(`btxml: port value not found`)
+)
+
+// Blackboard returns the blackboard (scope) of the node.
+func (p Ports) Blackboard() *bt.Blackboard {
+	return p.blackboard
+}
+
+// Raw returns the unprocessed value of the port, e.g. "{target}", and whether it was present.
+func (p Ports) Raw(name string) (string, bool) {
+	v, ok := p.values[name]
+	return v, ok
+}
+
+// Input returns the value of the named port, which will be read from the blackboard, if it's a reference, and
+// is otherwise parsed from the literal value. Strings (including blackboard values) are parsed as T using
+// encoding.TextUnmarshaler, if implemented, time.ParseDuration, for time.Duration, or fmt.Sscan. Input is intended to
+// be called within ticks, so that the current value of any blackboard keys is used.
+func Input[T any](p Ports, name string) (value T, err error) {
+	raw, ok := p.values[name]
+	if !ok {
+		err = fmt.Errorf(`%w: %s`, ErrPortNotFound, name)
+		return
+	}
+	key, ok := parseReference(name, raw)
+	if !ok {
+		return parsePort[T](name, raw)
+	}
+	v, ok := bt.NewKey[any](key).Get(p.blackboard)
+	if !ok {
+		err = fmt.Errorf(`%w: %s: %s`, ErrPortValueNotFound, name, key)
+		return
+	}
+	if value, ok = v.(T); ok {
+		return
+	}
+	if s, ok := v.(string); ok {
+		return parsePort[T](name, s)
+	}
+	err = fmt.Errorf(`btxml: port %s: expected %T, got %T`, name, value, v)
+	return
+}
+
+// Output sets the value of the named port, which must be a reference to a blackboard key, see Input.
+func Output[T any](p Ports, name string, value T) error {
+	raw, ok := p.values[name]
+	if !ok {
+		return fmt.Errorf(`%w: %s`, ErrPortNotFound, name)
+	}
+	key, ok := parseReference(name, raw)
+	if !ok {
+		return fmt.Errorf(`%w: %s`, ErrPortNotReference, name)
+	}
+	bt.NewKey[T](key).Set(p.blackboard, value)
+	return nil
+}
+
+func parsePort[T any](name, s string) (value T, err error) {
+	switch v := any(&value).(type) {
+	case *string:
+		*v = s
+	case encoding.TextUnmarshaler:
+		err = v.UnmarshalText([]byte(s))
+	case *time.Duration:
+		*v, err = time.ParseDuration(s)
+	default:
+		_, err = fmt.Sscan(s, &value)
+	}
+	if err != nil {
+		err = fmt.Errorf(`btxml: port %s: %w`, name, err)
+	}
+	return
+}
@@ -0,0 +1,124 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package btxml
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+)
+
+func TestInput(t *testing.T) {
+	blackboard := bt.NewBlackboard()
+	bt.NewKey[string](`count`).Set(blackboard, `7`)
+	bt.NewKey[int](`n`).Set(blackboard, 3)
+	ports := Ports{blackboard: blackboard, values: map[string]string{
+		`count`:    `{=}`,
+		`n`:        `{n}`,
+		`delay`:    `1.5s`,
+		`ratio`:    `0.25`,
+		`addr`:     `127.0.0.1`,
+		`literal`:  `{}`,
+		`missing`:  `{nope}`,
+		`reserved`: `x y`,
+	}}
+	if ports.Blackboard() != blackboard {
+		t.Error(ports.Blackboard())
+	}
+	if v, err := Input[int](ports, `count`); err != nil || v != 7 {
+		t.Error(v, err)
+	}
+	if v, err := Input[int](ports, `n`); err != nil || v != 3 {
+		t.Error(v, err)
+	}
+	if v, err := Input[time.Duration](ports, `delay`); err != nil || v != time.Second*3/2 {
+		t.Error(v, err)
+	}
+	if v, err := Input[float64](ports, `ratio`); err != nil || v != 0.25 {
+		t.Error(v, err)
+	}
+	if v, err := Input[netip.Addr](ports, `addr`); err != nil || v != netip.MustParseAddr(`127.0.0.1`) {
+		t.Error(v, err)
+	}
+	if v, err := Input[string](ports, `literal`); err != nil || v != `{}` {
+		t.Error(v, err)
+	}
+	if v, err := Input[string](ports, `reserved`); err != nil || v != `x y` {
+		t.Error(v, err)
+	}
+	if _, err := Input[string](ports, `n`); err == nil || err.Error() != `btxml: port n: expected string, got int` {
+		t.Error(err)
+	}
+	if _, err := Input[int](ports, `missing`); !errors.Is(err, ErrPortValueNotFound) {
+		t.Error(err)
+	}
+	if _, err := Input[int](ports, `other`); !errors.Is(err, ErrPortNotFound) {
+		t.Error(err)
+	}
+	if _, err := Input[time.Duration](ports, `ratio`); err == nil {
+		t.Error(`expected error`)
+	}
+}
+
+func TestOutput(t *testing.T) {
+	blackboard := bt.NewBlackboard()
+	ports := Ports{blackboard: blackboard, values: map[string]string{`a`: `{b}`, `c`: `{=}`, `d`: `literal`}}
+	if err := Output(ports, `a`, 1); err != nil {
+		t.Error(err)
+	}
+	if err := Output(ports, `c`, `x`); err != nil {
+		t.Error(err)
+	}
+	if v, _ := bt.NewKey[int](`b`).Get(blackboard); v != 1 {
+		t.Error(v)
+	}
+	if v, _ := bt.NewKey[string](`c`).Get(blackboard); v != `x` {
+		t.Error(v)
+	}
+	if err := Output(ports, `d`, 1); !errors.Is(err, ErrPortNotReference) {
+		t.Error(err)
+	}
+	if err := Output(ports, `e`, 1); !errors.Is(err, ErrPortNotFound) {
+		t.Error(err)
+	}
+}
+
+func TestRegistry_Load_subtreeScope(t *testing.T) {
+	r := NewRegistry()
+	r.Register(`Set`, func(ports Ports) (bt.Tick, error) {
+		return func([]bt.Node) (bt.Status, error) {
+			return bt.Success, Output(ports, `key`, `value`)
+		}, nil
+	})
+	blackboard := bt.NewBlackboard()
+	node, err := r.Load(``, []byte(`<root main_tree_to_execute="a">
+  <BehaviorTree ID="a"><SubTree ID="b"/></BehaviorTree>
+  <BehaviorTree ID="b"><Set key="{local}"/></BehaviorTree>
+</root>`), blackboard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status, err := node.Tick(); err != nil || status != bt.Success {
+		t.Fatal(status, err)
+	}
+	if _, ok := bt.NewKey[string](`local`).Get(blackboard); ok {
+		t.Error(`expected local to the subtree`)
+	}
+}
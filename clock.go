@@ -0,0 +1,117 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import "time"
+
+type (
+	// Clock models the time package, and may be used to control time-based behavior, e.g. for deterministic tests,
+	// see also WithClock, and the bttest package
+	Clock interface {
+		// Now returns the current time, see time.Now
+		Now() time.Time
+		// NewTicker returns a new ticker, see time.NewTicker
+		NewTicker(d time.Duration) ClockTicker
+		// NewTimer returns a new timer, see time.NewTimer
+		NewTimer(d time.Duration) ClockTimer
+		// After waits for the duration to elapse, then sends the current time on the returned channel, see time.After
+		After(d time.Duration) <-chan time.Time
+	}
+
+	// ClockTicker models a time.Ticker, see Clock
+	ClockTicker interface {
+		// C returns the channel on which the ticks are delivered
+		C() <-chan time.Time
+		// Reset stops the ticker and resets its period to the specified duration
+		Reset(d time.Duration)
+		// Stop turns off the ticker
+		Stop()
+	}
+
+	// ClockTimer models a time.Timer, see Clock
+	ClockTimer interface {
+		// C returns the channel on which the time is delivered
+		C() <-chan time.Time
+		// Reset changes the timer to expire after duration d, returning true if the timer had been active
+		Reset(d time.Duration) bool
+		// Stop prevents the timer from firing, returning true if the timer had been active
+		Stop() bool
+	}
+
+	// ClockOption configures the Clock used by time-based implementations, and may be passed to NewTicker,
+	// NewTickerStopOnFailure, NewEventTicker, NewManager, Supervise, RateLimit, Cooldown, Debounce, Timeout, Retry,
+	// and Trace
+	ClockOption struct {
+		clock Clock
+	}
+
+	systemClock struct{}
+
+	systemTicker struct{ *time.Ticker }
+
+	systemTimer struct{ *time.Timer }
+)
+
+var (
+	// DefaultClock is the Clock used if none is configured, and uses the time package
+	DefaultClock Clock = systemClock{}
+)
+
+// WithClock returns an option that configures the clock, a nil clock will use DefaultClock
+func WithClock(clock Clock) ClockOption {
+	return ClockOption{clock: clock}
+}
+
+func (o ClockOption) applyTimeout(c *timeoutConfig) { c.clock = o.clock }
+
+func (o ClockOption) applyTicker(c *tickerConfig) { c.clock = o.clock }
+
+func (o ClockOption) applyRateLimit(c *rateLimitConfig) { c.clock = o.clock }
+
+func (o ClockOption) applyCooldown(c *cooldownConfig) { c.clock = o.clock }
+
+func (o ClockOption) applyDebounce(c *debounceConfig) { c.clock = o.clock }
+
+func (o ClockOption) applyEventTicker(c *eventTickerConfig) { c.clock = o.clock }
+
+func (o ClockOption) applyTrace(c *traceConfig) { c.clock = o.clock }
+
+func (o ClockOption) applyManager(c *managerConfig) { c.clock = o.clock }
+
+func (o ClockOption) applyRetry(c *retryConfig) { c.clock = o.clock }
+
+func (o ClockOption) applySupervise(c *superviseConfig) { c.clock = o.clock }
+
+// orDefaultClock returns clock, or DefaultClock, if clock is nil
+func orDefaultClock(clock Clock) Clock {
+	if clock == nil {
+		return DefaultClock
+	}
+	return clock
+}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+func (systemClock) NewTicker(d time.Duration) ClockTicker { return systemTicker{time.NewTicker(d)} }
+
+func (systemClock) NewTimer(d time.Duration) ClockTimer { return systemTimer{time.NewTimer(d)} }
+
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+func (t systemTicker) C() <-chan time.Time { return t.Ticker.C }
+
+func (t systemTimer) C() <-chan time.Time { return t.Timer.C }
@@ -0,0 +1,50 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDefaultClock(t *testing.T) {
+	if v := time.Since(DefaultClock.Now()); v < 0 || v > time.Minute {
+		t.Error(v)
+	}
+	ticker := DefaultClock.NewTicker(time.Millisecond)
+	<-ticker.C()
+	ticker.Reset(time.Millisecond * 2)
+	<-ticker.C()
+	ticker.Stop()
+	timer := DefaultClock.NewTimer(time.Millisecond)
+	<-timer.C()
+	if timer.Reset(time.Hour) {
+		t.Error(`expected inactive`)
+	}
+	if !timer.Stop() {
+		t.Error(`expected active`)
+	}
+	<-DefaultClock.After(time.Millisecond)
+}
+
+func TestWithClock_nil(t *testing.T) {
+	var c tickerConfig
+	WithClock(nil).applyTicker(&c)
+	if orDefaultClock(c.clock) != DefaultClock {
+		t.Error(`expected default clock`)
+	}
+}
@@ -0,0 +1,68 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import "time"
+
+type (
+	// CooldownOption configures the behavior of Cooldown, see also WithClock
+	CooldownOption interface {
+		applyCooldown(c *cooldownConfig)
+	}
+
+	cooldownConfig struct {
+		status Status
+		clock  Clock
+	}
+
+	cooldownOptionFunc func(c *cooldownConfig)
+)
+
+// Cooldown wraps a tick such that, each time it completes, i.e. returns a non-running status, or an error, it won't
+// be ticked again until the given duration has elapsed, returning failure (see WithCooldownStatus) in the interim.
+// Like RateLimit, the duration is inclusive of the start, i.e. the tick may be ticked again exactly d after it
+// completed. Nil will be returned if tick is nil.
+func Cooldown(d time.Duration, tick Tick, options ...CooldownOption) Tick {
+	if tick == nil {
+		return nil
+	}
+	c := cooldownConfig{status: Failure}
+	for _, o := range options {
+		o.applyCooldown(&c)
+	}
+	clock := orDefaultClock(c.clock)
+	var last *time.Time
+	return decorate(tick, func(children []Node) (Status, error) {
+		if last != nil && clock.Now().Add(-d).Before(*last) {
+			return c.status, nil
+		}
+		last = nil
+		status, err := tick(children)
+		if err != nil || status != Running {
+			now := clock.Now()
+			last = &now
+		}
+		return status, err
+	})
+}
+
+// WithCooldownStatus configures Cooldown to return the given status while cooling down, the default is Failure.
+func WithCooldownStatus(status Status) CooldownOption {
+	return cooldownOptionFunc(func(c *cooldownConfig) { c.status = status })
+}
+
+func (f cooldownOptionFunc) applyCooldown(c *cooldownConfig) { f(c) }
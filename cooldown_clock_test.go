@@ -0,0 +1,65 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree_test
+
+import (
+	"testing"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+	"github.com/joeycumines/go-behaviortree/bttest"
+)
+
+func TestCooldown_clock(t *testing.T) {
+	var (
+		c      = bttest.NewClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		count  int
+		status = bt.Running
+		tick   = bt.Cooldown(time.Second, func(children []bt.Node) (bt.Status, error) {
+			count++
+			return status, nil
+		}, bt.WithClock(c), bt.WithCooldownStatus(bt.Running))
+	)
+	for i, expected := range []bt.Status{bt.Running, bt.Running} {
+		if status, err := tick(nil); err != nil || status != expected {
+			t.Fatal(i, status, err)
+		}
+		c.Advance(time.Second)
+	}
+	status = bt.Success
+	if status, err := tick(nil); err != nil || status != bt.Success {
+		t.Fatal(status, err)
+	}
+	if count != 3 {
+		t.Fatal(count)
+	}
+	c.Advance(time.Second - 1)
+	if status, err := tick(nil); err != nil || status != bt.Running {
+		t.Fatal(status, err)
+	}
+	if count != 3 {
+		t.Fatal(count)
+	}
+	c.Advance(1)
+	status = bt.Failure
+	if status, err := tick(nil); err != nil || status != bt.Failure {
+		t.Fatal(status, err)
+	}
+	if count != 4 {
+		t.Fatal(count)
+	}
+}
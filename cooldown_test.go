@@ -0,0 +1,65 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCooldown_nil(t *testing.T) {
+	if Cooldown(time.Second, nil) != nil {
+		t.Error(`expected nil`)
+	}
+}
+
+func TestCooldown_error(t *testing.T) {
+	var (
+		e     = errors.New(`some_error`)
+		count int
+		tick  = Cooldown(time.Hour, func(children []Node) (Status, error) {
+			count++
+			return Failure, e
+		})
+	)
+	if status, err := tick(nil); err != e || status != Failure {
+		t.Fatal(status, err)
+	}
+	if status, err := tick(nil); err != nil || status != Failure {
+		t.Fatal(status, err)
+	}
+	if count != 1 {
+		t.Error(count)
+	}
+}
+
+func TestCooldown_zero(t *testing.T) {
+	var count int
+	tick := Cooldown(0, func(children []Node) (Status, error) {
+		count++
+		return Success, nil
+	}, WithCooldownStatus(Running))
+	for i := 0; i < 3; i++ {
+		if status, err := tick(nil); err != nil || status != Success {
+			t.Fatal(status, err)
+		}
+	}
+	if count != 3 {
+		t.Error(count)
+	}
+}
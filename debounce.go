@@ -0,0 +1,78 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import "time"
+
+type (
+	// DebounceOption configures the behavior of Debounce, see also WithClock
+	DebounceOption interface {
+		applyDebounce(c *debounceConfig)
+	}
+
+	debounceConfig struct {
+		status Status
+		clock  Clock
+	}
+
+	debounceOptionFunc func(c *debounceConfig)
+)
+
+// Debounce wraps a tick, typically a condition, such that success will be returned only once it has returned success
+// on every tick, for at least the given duration, measured from the first success, returning failure (see
+// WithDebounceStatus) in the interim. Any other result will be returned as-is, and will reset the period. A duration
+// <= 0 disables debouncing. Nil will be returned if tick is nil.
+//
+// Used as the first child of a Sequence (or ReactiveSequence), the subsequent children will only be ticked once the
+// condition has held steadily for the duration, e.g. to avoid flapping.
+func Debounce(d time.Duration, tick Tick, options ...DebounceOption) Tick {
+	if tick == nil {
+		return nil
+	}
+	c := debounceConfig{status: Failure}
+	for _, o := range options {
+		o.applyDebounce(&c)
+	}
+	clock := orDefaultClock(c.clock)
+	var since *time.Time
+	return Tick(func(children []Node) (Status, error) {
+		status, err := tick(children)
+		if err != nil || status != Success {
+			since = nil
+			return status, err
+		}
+		now := clock.Now()
+		if since == nil {
+			since = &now
+		}
+		if now.Add(-d).Before(*since) {
+			return c.status, nil
+		}
+		return Success, nil
+	}).WithHalt(func(children []Node) {
+		since = nil
+		tick.Halt(children)
+	})
+}
+
+// WithDebounceStatus configures Debounce to return the given status while the wrapped tick has not yet succeeded for
+// the full duration, the default is Failure, though Running may be more appropriate, in some cases.
+func WithDebounceStatus(status Status) DebounceOption {
+	return debounceOptionFunc(func(c *debounceConfig) { c.status = status })
+}
+
+func (f debounceOptionFunc) applyDebounce(c *debounceConfig) { f(c) }
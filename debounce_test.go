@@ -0,0 +1,62 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDebounce_nil(t *testing.T) {
+	if Debounce(time.Second, nil) != nil {
+		t.Error(`expected nil`)
+	}
+}
+
+func TestDebounce_sequence(t *testing.T) {
+	var (
+		e     = errors.New(`some_error`)
+		err   error
+		count int
+		node  = New(
+			Sequence,
+			New(Debounce(time.Hour, func(children []Node) (Status, error) { return Success, err }, WithDebounceStatus(Running))),
+			New(func(children []Node) (Status, error) {
+				count++
+				return Success, nil
+			}),
+		)
+	)
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	err = e
+	if status, err := node.Tick(); err != e || status != Failure {
+		t.Fatal(status, err)
+	}
+	if count != 0 {
+		t.Error(count)
+	}
+}
+
+func TestDebounce_zero(t *testing.T) {
+	tick := Debounce(0, func(children []Node) (Status, error) { return Success, nil })
+	if status, err := tick(nil); err != nil || status != Success {
+		t.Error(status, err)
+	}
+}
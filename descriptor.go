@@ -0,0 +1,97 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"unsafe"
+)
+
+// nodeDescriptor is a side channel, allowing values to be resolved without expanding the node, or scanning the
+// stack, see Node.Value. Nodes implemented by this package (New, NewNode, Node.WithValue) are method values of
+// nodeDescriptor.node, and are recognised by descriptorOf, any other node will fall back to expansion.
+//
+// Expanding the node will register the provider (if any), then return the result of expanding next, if non-nil,
+// otherwise tick and children, meaning it behaves identically to the equivalent closure.
+type nodeDescriptor struct {
+	// provider is consulted first, and may be nil
+	provider ValueProvider
+	// next is the node to continue with, if provider didn't match, where nil indicates there are no further values
+	next     Node
+	tick     Tick
+	children []Node
+}
+
+// descriptorPC is the code pointer of nodeDescriptor.node method values, or 0 if they can't be recognised
+var descriptorPC = func() uintptr {
+	d := new(nodeDescriptor)
+	n := d.Node()
+	if f := funcValue(n); f != nil && f.fn != 0 && f.recv == unsafe.Pointer(d) {
+		return f.fn
+	}
+	return 0
+}()
+
+// methodValue models the layout of a method value, as implemented by the gc compiler, i.e. a func value is a
+// pointer to a closure, consisting of the code pointer, followed by any context, which is the receiver
+type methodValue struct {
+	fn   uintptr
+	recv unsafe.Pointer
+}
+
+func funcValue(n Node) *methodValue { return *(**methodValue)(unsafe.Pointer(&n)) }
+
+// descriptorOf returns the descriptor the node was created from, or nil, in O(1)
+func descriptorOf(n Node) *nodeDescriptor {
+	if descriptorPC == 0 || n == nil {
+		return nil
+	}
+	if f := funcValue(n); f.fn == descriptorPC {
+		return (*nodeDescriptor)(f.recv)
+	}
+	return nil
+}
+
+// Node returns the receiver as a node, which will be recognised by descriptorOf.
+func (d *nodeDescriptor) Node() Node { return d.node }
+
+func (d *nodeDescriptor) node() (Tick, []Node) {
+	if d.provider != nil {
+		UseValueProvider(d.provider)
+	}
+	if d.next != nil {
+		return d.next()
+	}
+	return d.tick, d.children
+}
+
+// descriptorValue resolves key using descriptors, in order, returning the value, or the first node that isn't a
+// descriptor, which must be resolved using the (slower) expansion-based mechanism, see Node.valueSync
+func descriptorValue(n Node, key any) (any, Node) {
+	for n != nil {
+		d := descriptorOf(n)
+		if d == nil {
+			return nil, n
+		}
+		if d.provider != nil {
+			if v, ok := d.provider.Value(key); ok {
+				return v, nil
+			}
+		}
+		n = d.next
+	}
+	return nil, nil
+}
@@ -0,0 +1,153 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDescriptorOf(t *testing.T) {
+	if descriptorPC == 0 {
+		t.Fatal(`expected descriptors to be recognised`)
+	}
+	d := &nodeDescriptor{tick: Sequence}
+	if v := descriptorOf(d.Node()); v != d {
+		t.Error(v)
+	}
+	if v := descriptorOf(nn(Sequence)); v != nil {
+		t.Error(v)
+	}
+	if v := descriptorOf(nil); v != nil {
+		t.Error(v)
+	}
+	if v := descriptorOf(New(Sequence)); v == nil || v.tick == nil || v.provider == nil {
+		t.Error(v)
+	}
+	if v := descriptorOf(New(Sequence).WithValue(1, 2)); v == nil || v.next == nil || v.provider == nil {
+		t.Error(v)
+	}
+}
+
+func TestNode_Value_descriptorNoLock(t *testing.T) {
+	type k1 struct{}
+	type k2 struct{}
+	node := New(Sequence, New(Selector)).WithValue(k1{}, 1).WithName(`name`)
+	// exhaust the slots, such that any lookups requiring expansion would block
+	for i := 0; i < valueSlotCount; i++ {
+		<-valueSlotFree
+	}
+	defer func() {
+		for i := 0; i < valueSlotCount; i++ {
+			valueSlotFree <- i
+		}
+	}()
+	if v := node.Value(k1{}); v != 1 {
+		t.Error(v)
+	}
+	if v := node.Value(k2{}); v != nil {
+		t.Error(v)
+	}
+	if v := node.Name(); v != `name` {
+		t.Error(v)
+	}
+	if v := node.Frame(); v == nil || v.Function != `github.com/joeycumines/go-behaviortree.TestNode_Value_descriptorNoLock` {
+		t.Error(v)
+	}
+}
+
+func TestNode_Value_descriptorFallback(t *testing.T) {
+	type k1 struct{}
+	type k2 struct{}
+	type k3 struct{}
+	custom := Node(func() (Tick, []Node) {
+		UseValueHandler(func(key any) (any, bool) {
+			if key == (k2{}) {
+				return `custom`, true
+			}
+			return nil, false
+		})
+		return Sequence, nil
+	})
+	node := custom.WithValue(k1{}, `outer`)
+	if v := node.Value(k1{}); v != `outer` {
+		t.Error(v)
+	}
+	if v := node.Value(k2{}); v != `custom` {
+		t.Error(v)
+	}
+	if v := node.Value(k3{}); v != nil {
+		t.Error(v)
+	}
+	// values of descriptors must still be registered when they are expanded
+	wrapped := Node(func() (Tick, []Node) { return node() })
+	if v := wrapped.Value(k1{}); v != `outer` {
+		t.Error(v)
+	}
+	if v := wrapped.Value(k2{}); v != `custom` {
+		t.Error(v)
+	}
+	if tick, children := wrapped(); tick == nil || children != nil {
+		t.Error(tick, children)
+	}
+}
+
+func TestNode_WithValueProvider(t *testing.T) {
+	type k1 struct{}
+	type k2 struct{}
+	node := New(Sequence).WithValue(k1{}, `inner`)
+	if v := node.WithValueProvider(nil); descriptorOf(v) != descriptorOf(node) {
+		t.Error(`expected receiver`)
+	}
+	provided := node.WithValueProvider(ValueProviderFunc(func(key any) (any, bool) {
+		switch key {
+		case k1{}:
+			return `outer`, true
+		case k2{}:
+			return nil, true
+		}
+		return nil, false
+	}))
+	if v := provided.Value(k1{}); v != `outer` {
+		t.Error(v)
+	}
+	if v := provided.Value(k2{}); v != nil {
+		t.Error(v)
+	}
+	if v := provided.WithValue(k1{}, `outermost`).Value(k1{}); v != `outermost` {
+		t.Error(v)
+	}
+	if v := node.Value(k1{}); v != `inner` {
+		t.Error(v)
+	}
+	if tick, _ := provided(); tick == nil {
+		t.Error(`expected tick`)
+	}
+	if v := Node(func() (Tick, []Node) { return provided() }).Value(k1{}); v != `outer` {
+		t.Error(v)
+	}
+}
+
+func TestNode_WithValueProvider_panicNilReceiver(t *testing.T) {
+	defer func() {
+		if r := fmt.Sprint(recover()); r != `behaviortree.Node.WithValueProvider nil receiver` {
+			t.Error(r)
+		}
+	}()
+	Node(nil).WithValueProvider(ValueProviders{})
+	t.Error(`expected panic`)
+}
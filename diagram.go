@@ -0,0 +1,138 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+)
+
+type (
+	// MermaidPrinter is an implementation of Printer that writes a Mermaid flowchart, of the logical structure of the
+	// tree, see Walk
+	MermaidPrinter struct {
+		// Label configures the label for each node, and defaults to MetadataLabel if nil
+		Label func(n Metadata) string
+		// Direction is the direction of the flowchart, and defaults to "TD" (top-down) if empty
+		Direction string
+	}
+
+	// PlantUMLPrinter is an implementation of Printer that writes a PlantUML mindmap, of the logical structure of
+	// the tree, see Walk
+	PlantUMLPrinter struct {
+		// Label configures the label for each node, and defaults to MetadataLabel if nil
+		Label func(n Metadata) string
+	}
+)
+
+var (
+	mermaidEscaper  = strings.NewReplacer(`"`, `#quot;`, "\n", `<br>`)
+	plantUMLEscaper = strings.NewReplacer("\n", `\n`)
+)
+
+// MetadataLabel is the default label for MermaidPrinter and PlantUMLPrinter, which is the name (see GetName),
+// falling back to the function of the frame (see GetFrame and Node.Frame), without the package path, or "-".
+func MetadataLabel(n Metadata) string {
+	if isNilMetadata(n) {
+		return `<nil>`
+	}
+	if name := GetName(n); name != `` {
+		return name
+	}
+	if frame := metadataFrame(n); frame != nil && frame.Function != `` {
+		label := frame.Function
+		if i := strings.LastIndex(label, `/`); i >= 0 {
+			label = label[i+1:]
+		}
+		return label
+	}
+	return `-`
+}
+
+// Fprint implements Printer.Fprint
+func (p MermaidPrinter) Fprint(output io.Writer, node Node) error {
+	label := p.Label
+	if label == nil {
+		label = MetadataLabel
+	}
+	direction := p.Direction
+	if direction == `` {
+		direction = `TD`
+	}
+	var b bytes.Buffer
+	b.WriteString("flowchart " + direction + "\n")
+	var id int
+	walkDiagram(node, func(n Metadata, parent int) int {
+		name := `n` + strconv.Itoa(id)
+		b.WriteString("\t" + name + `["` + mermaidEscaper.Replace(label(n)) + "\"]\n")
+		if parent >= 0 {
+			b.WriteString("\tn" + strconv.Itoa(parent) + ` --> ` + name + "\n")
+		}
+		id++
+		return id - 1
+	}, -1)
+	_, err := b.WriteTo(output)
+	return err
+}
+
+// Fprint implements Printer.Fprint
+func (p PlantUMLPrinter) Fprint(output io.Writer, node Node) error {
+	label := p.Label
+	if label == nil {
+		label = MetadataLabel
+	}
+	var b bytes.Buffer
+	b.WriteString("@startmindmap\n")
+	walkDiagram(node, func(n Metadata, depth int) int {
+		b.WriteString(strings.Repeat(`*`, depth+1) + ` ` + plantUMLEscaper.Replace(label(n)) + "\n")
+		return depth + 1
+	}, 0)
+	b.WriteString("@endmindmap\n")
+	_, err := b.WriteTo(output)
+	return err
+}
+
+// walkDiagram visits n then its (logical) children depth-first, like Walk, passing the state returned by visiting
+// each parent, and treating nil nodes as leaves
+func walkDiagram(n Metadata, visit func(n Metadata, state int) int, state int) {
+	state = visit(n, state)
+	if isNilMetadata(n) {
+		return
+	}
+	n.Children(func(child Metadata) bool {
+		walkDiagram(child, visit, state)
+		return true
+	})
+}
+
+// metadataFrame returns the frame for n, using Node.Frame if n is a Node, otherwise GetFrame
+func metadataFrame(n Metadata) *Frame {
+	if node, ok := n.(Node); ok {
+		return node.Frame()
+	}
+	return GetFrame(n)
+}
+
+func isNilMetadata(n Metadata) bool {
+	if n == nil {
+		return true
+	}
+	node, ok := n.(Node)
+	return ok && node == nil
+}
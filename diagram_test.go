@@ -0,0 +1,139 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+)
+
+// diagramMetadata is a virtual node, for testing rendering of logical structure
+type diagramMetadata struct {
+	name     string
+	frame    *Frame
+	children []Metadata
+}
+
+func (m diagramMetadata) Value(key any) any {
+	switch key {
+	case vkName{}:
+		if m.name != `` {
+			return m.name
+		}
+	case vkFrame{}:
+		if m.frame != nil {
+			return m.frame
+		}
+	}
+	return nil
+}
+
+func (m diagramMetadata) Children(yield func(Metadata) bool) {
+	for _, child := range m.children {
+		if !yield(child) {
+			return
+		}
+	}
+}
+
+func newDiagramTestTree() Node {
+	leaf := New(func([]Node) (Status, error) { return Success, nil })
+	return New(
+		Selector,
+		New(Sequence, leaf, leaf).
+			WithName(`virtual`).
+			WithStructure(slices.Values([]Metadata{
+				diagramMetadata{name: `say "hi"`},
+				diagramMetadata{frame: &Frame{Function: `example.com/pkg.Step`}, children: []Metadata{
+					diagramMetadata{name: "multi\nline"},
+					diagramMetadata{},
+				}},
+			})),
+		nil,
+		leaf.WithName(`leaf`),
+	).WithName(`root`)
+}
+
+func TestMermaidPrinter_Fprint(t *testing.T) {
+	var b bytes.Buffer
+	if err := (MermaidPrinter{}).Fprint(&b, newDiagramTestTree()); err != nil {
+		t.Fatal(err)
+	}
+	if v := b.String(); v != `flowchart TD
+	n0["root"]
+	n1["virtual"]
+	n0 --> n1
+	n2["say #quot;hi#quot;"]
+	n1 --> n2
+	n3["pkg.Step"]
+	n1 --> n3
+	n4["multi<br>line"]
+	n3 --> n4
+	n5["-"]
+	n3 --> n5
+	n6["<nil>"]
+	n0 --> n6
+	n7["leaf"]
+	n0 --> n7
+` {
+		t.Error(v)
+	}
+}
+
+func TestMermaidPrinter_Fprint_options(t *testing.T) {
+	var b bytes.Buffer
+	err := MermaidPrinter{
+		Label:     func(n Metadata) string { return `x` },
+		Direction: `LR`,
+	}.Fprint(&b, New(Sequence, New(Sequence)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := b.String(); v != "flowchart LR\n\tn0[\"x\"]\n\tn1[\"x\"]\n\tn0 --> n1\n" {
+		t.Error(v)
+	}
+}
+
+func TestPlantUMLPrinter_Fprint(t *testing.T) {
+	var b bytes.Buffer
+	if err := (PlantUMLPrinter{}).Fprint(&b, newDiagramTestTree()); err != nil {
+		t.Fatal(err)
+	}
+	if v := b.String(); v != `@startmindmap
+* root
+** virtual
+*** say "hi"
+*** pkg.Step
+**** multi\nline
+**** -
+** <nil>
+** leaf
+@endmindmap
+` {
+		t.Error(v)
+	}
+}
+
+func TestMetadataLabel(t *testing.T) {
+	if v := MetadataLabel(nil); v != `<nil>` {
+		t.Error(v)
+	}
+	if v := MetadataLabel(New(Sequence)); v != `go-behaviortree.TestMetadataLabel` {
+		t.Error(v)
+	}
+}
@@ -0,0 +1,132 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+)
+
+type (
+	// DOTPrinter is an implementation of Printer that writes a Graphviz digraph, see also DOTPrinterInspector
+	DOTPrinter struct {
+		// Inspector configures the label and shape for a node with a given tick and children, and defaults to
+		// DOTPrinterInspector if nil
+		Inspector func(node Node, tick Tick, children []Node) (label, shape string)
+		// Status may optionally be provided to colour each node by the status it last returned, if known (ok)
+		Status func(node Node) (status Status, ok bool)
+	}
+)
+
+var (
+	// dotShapes maps the (unqualified) function names of ticks implemented by this package to Graphviz shapes
+	dotShapes = map[string]string{
+		`Sequence`: `cds`,
+		`Selector`: `diamond`,
+		`All`:      `parallelogram`,
+		`Parallel`: `parallelogram`,
+		`Fork`:     `parallelogram`,
+		`Switch`:   `hexagon`,
+	}
+
+	dotStatusColors = map[Status]string{
+		Running: `gold`,
+		Success: `palegreen`,
+		Failure: `salmon`,
+	}
+
+	dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+)
+
+// DOTPrinterInspector is the default DOTPrinter.Inspector, which labels nodes using Node.Name, falling back to the
+// function of Node.Frame, followed by the file and line of the frame, if known. Ticks provided by this package have
+// distinct shapes per kind of composite (e.g. Sequence, Selector, Parallel), otherwise nodes with children are boxes,
+// and leaf nodes are ellipses.
+func DOTPrinterInspector(node Node, tick Tick, children []Node) (label, shape string) {
+	if node == nil {
+		return `<nil>`, `plaintext`
+	}
+	frame := node.Frame()
+	label = node.Name()
+	if label == `` && frame != nil {
+		label = frame.Function
+		if i := strings.LastIndex(label, `/`); i >= 0 {
+			label = label[i+1:]
+		}
+	}
+	if label == `` {
+		label = `-`
+	}
+	if frame != nil && frame.File != `` {
+		label += "\n" + shortFileLine(frame.File, frame.Line)
+	}
+	shape = dotShapes[tick.Kind()]
+	if shape == `` {
+		if len(children) != 0 {
+			shape = `box`
+		} else {
+			shape = `ellipse`
+		}
+	}
+	return
+}
+
+// Fprint implements Printer.Fprint
+func (p DOTPrinter) Fprint(output io.Writer, node Node) error {
+	var b bytes.Buffer
+	b.WriteString("digraph {\n")
+	var id int
+	p.build(&b, &id, node)
+	b.WriteString("}\n")
+	_, err := b.WriteTo(output)
+	return err
+}
+
+func (p DOTPrinter) build(b *bytes.Buffer, id *int, node Node) string {
+	inspector := p.Inspector
+	if inspector == nil {
+		inspector = DOTPrinterInspector
+	}
+	var (
+		tick     Tick
+		children []Node
+	)
+	if node != nil {
+		tick, children = node()
+	}
+	label, shape := inspector(node, tick, children)
+	name := `n` + strconv.Itoa(*id)
+	*id++
+	b.WriteString("\t" + name + ` [label="` + dotEscaper.Replace(label) + `"`)
+	if shape != `` {
+		b.WriteString(`, shape=` + shape)
+	}
+	if p.Status != nil && node != nil {
+		if status, ok := p.Status(node); ok {
+			if color, ok := dotStatusColors[status]; ok {
+				b.WriteString(`, style=filled, fillcolor=` + color)
+			}
+		}
+	}
+	b.WriteString("];\n")
+	for _, child := range children {
+		b.WriteString("\t" + name + ` -> ` + p.build(b, id, child) + ";\n")
+	}
+	return name
+}
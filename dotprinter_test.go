@@ -0,0 +1,113 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestDOTPrinter_Fprint(t *testing.T) {
+	var (
+		leaf = New(func([]Node) (Status, error) { return Success, nil })
+		node = New(
+			Selector,
+			New(Sequence, leaf.WithName(`a "quoted"`), leaf.WithName("b\nc")).WithName(`seq`),
+			nil,
+			New(Parallel(SuccessOnAll, FailOnOne), leaf).WithName(`par`),
+		).WithName(`root`)
+		b bytes.Buffer
+	)
+	err := DOTPrinter{
+		Inspector: func(node Node, tick Tick, children []Node) (string, string) {
+			label, shape := DOTPrinterInspector(node, tick, children)
+			if i := strings.IndexByte(label, '\n'); i >= 0 && node.Name() != "b\nc" {
+				label = label[:i]
+			}
+			return label, shape
+		},
+		Status: func(node Node) (Status, bool) {
+			switch node.Name() {
+			case `root`:
+				return Running, true
+			case `seq`:
+				return Failure, true
+			case `par`:
+				return Success, true
+			}
+			return 0, false
+		},
+	}.Fprint(&b, node)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := b.String(); v != `digraph {
+	n0 [label="root", shape=diamond, style=filled, fillcolor=gold];
+	n1 [label="seq", shape=cds, style=filled, fillcolor=salmon];
+	n2 [label="a \"quoted\"", shape=ellipse];
+	n1 -> n2;
+	n3 [label="b\nc\ndotprinter_test.go:27", shape=ellipse];
+	n1 -> n3;
+	n0 -> n1;
+	n4 [label="<nil>", shape=plaintext];
+	n0 -> n4;
+	n5 [label="par", shape=parallelogram, style=filled, fillcolor=palegreen];
+	n6 [label="go-behaviortree.TestDOTPrinter_Fprint", shape=ellipse];
+	n5 -> n6;
+	n0 -> n5;
+}
+` {
+		t.Error(v)
+	}
+}
+
+func TestDOTPrinter_Fprint_nil(t *testing.T) {
+	var b bytes.Buffer
+	if err := (DOTPrinter{}).Fprint(&b, nil); err != nil {
+		t.Fatal(err)
+	}
+	if v := b.String(); v != "digraph {\n\tn0 [label=\"<nil>\", shape=plaintext];\n}\n" {
+		t.Error(v)
+	}
+}
+
+func TestDOTPrinterInspector(t *testing.T) {
+	for _, tc := range []struct {
+		Name  string
+		Node  Node
+		Shape string
+	}{
+		{`all`, New(All, New(Sequence)), `parallelogram`},
+		{`switch`, New(Switch), `hexagon`},
+		{`fork`, New(Fork()), `parallelogram`},
+		{`composite`, New(Memorize(Sequence), New(Sequence)), `box`},
+		{`leaf`, New(Not(Sequence)), `ellipse`},
+		{`nil tick`, func() (Tick, []Node) { return nil, nil }, `ellipse`},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			tick, children := tc.Node()
+			label, shape := DOTPrinterInspector(tc.Node, tick, children)
+			if shape != tc.Shape {
+				t.Error(shape)
+			}
+			if label == `` {
+				t.Error(`expected label`)
+			}
+		})
+	}
+}
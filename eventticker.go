@@ -0,0 +1,260 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+type (
+	// EventTicker is a Ticker that ticks on demand, rather than periodically, see NewEventTicker
+	EventTicker interface {
+		Ticker
+
+		// Wake requests a tick, without blocking. Multiple requests made prior to the next tick will be coalesced.
+		Wake()
+	}
+
+	// EventTickerOption configures the behavior of NewEventTicker, see also WithClock
+	EventTickerOption interface {
+		applyEventTicker(c *eventTickerConfig)
+	}
+
+	eventTickerConfig struct {
+		clock    Clock
+		triggers []func(done <-chan struct{}, wake func())
+		min      time.Duration
+		max      time.Duration
+	}
+
+	eventTickerOptionFunc func(c *eventTickerConfig)
+
+	// eventTicker is the implementation of EventTicker
+	eventTicker struct {
+		ctx      context.Context
+		cancel   context.CancelFunc
+		node     Node
+		clock    Clock
+		triggers []func(done <-chan struct{}, wake func())
+		min      time.Duration
+		max      time.Duration
+		wake     chan struct{}
+		done     chan struct{}
+		stop     chan struct{}
+		once     sync.Once
+		mutex    sync.Mutex
+		err      error
+		stats    *tickStats
+	}
+)
+
+// NewEventTicker constructs a new EventTicker, which ticks the provided node each time it is woken, via
+// EventTicker.Wake, or any trigger channel (see WithEventTrigger), note that a panic will occur if ctx or node are
+// nil, or if the minimum interval is greater than the (non-zero) maximum interval.
+//
+// Wake requests are coalesced, meaning a burst will result in a single tick, though any request made while ticking
+// will result in another tick. The node isn't ticked on start, though Wake may be called immediately. The frequency
+// of ticks may be bounded using WithEventMinInterval and WithEventMaxInterval.
+//
+// As with NewTicker, the node will tick until the first error or Ticker.Stop is called, or context is canceled, and
+// the result may be registered with a Manager.
+func NewEventTicker(ctx context.Context, node Node, options ...EventTickerOption) EventTicker {
+	if ctx == nil {
+		panic(errors.New("behaviortree.NewEventTicker nil context"))
+	}
+
+	if node == nil {
+		panic(errors.New("behaviortree.NewEventTicker nil node"))
+	}
+
+	var c eventTickerConfig
+	for _, o := range options {
+		o.applyEventTicker(&c)
+	}
+
+	if c.max > 0 && c.min > c.max {
+		panic(errors.New("behaviortree.NewEventTicker min interval > max interval"))
+	}
+
+	result := &eventTicker{
+		node:     node,
+		clock:    orDefaultClock(c.clock),
+		triggers: c.triggers,
+		min:      c.min,
+		max:      c.max,
+		stats:    newTickStats(0),
+		wake:     make(chan struct{}, 1),
+		done:     make(chan struct{}),
+		stop:     make(chan struct{}),
+	}
+
+	result.ctx, result.cancel = context.WithCancel(ctx)
+
+	go result.run()
+
+	return result
+}
+
+// WithEventTrigger configures NewEventTicker to wake each time a value is received from ch, until it is closed. The
+// values are discarded. May be provided multiple times.
+func WithEventTrigger[T any](ch <-chan T) EventTickerOption {
+	return eventTickerOptionFunc(func(c *eventTickerConfig) {
+		c.triggers = append(c.triggers, func(done <-chan struct{}, wake func()) {
+			for {
+				select {
+				case <-done:
+					return
+				case _, ok := <-ch:
+					if !ok {
+						return
+					}
+					wake()
+				}
+			}
+		})
+	})
+}
+
+// WithEventMinInterval configures NewEventTicker to delay any tick until at least d has elapsed since the start of
+// the previous tick, a value <= 0 (the default) disables the limit.
+func WithEventMinInterval(d time.Duration) EventTickerOption {
+	return eventTickerOptionFunc(func(c *eventTickerConfig) { c.min = d })
+}
+
+// WithEventMaxInterval configures NewEventTicker to tick if it hasn't been woken within d of the previous tick (or
+// start), a value <= 0 (the default) disables the limit.
+func WithEventMaxInterval(d time.Duration) EventTickerOption {
+	return eventTickerOptionFunc(func(c *eventTickerConfig) { c.max = d })
+}
+
+func (f eventTickerOptionFunc) applyEventTicker(c *eventTickerConfig) { f(c) }
+
+func (t *eventTicker) run() {
+	var wg sync.WaitGroup
+	for _, trigger := range t.triggers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			trigger(t.ctx.Done(), t.Wake)
+		}()
+	}
+
+	var (
+		err     error
+		last    time.Time
+		timer   ClockTimer
+		timeout <-chan time.Time
+	)
+	if t.max > 0 {
+		timer = t.clock.NewTimer(t.max)
+		timeout = timer.C()
+	}
+
+TickLoop:
+	for err == nil {
+		select {
+		case <-t.ctx.Done():
+			err = t.ctx.Err()
+			break TickLoop
+		case <-t.stop:
+			break TickLoop
+		case <-t.wake:
+		case <-timeout:
+		}
+
+		if t.min > 0 && !last.IsZero() {
+			if d := last.Add(t.min).Sub(t.clock.Now()); d > 0 {
+				delay := t.clock.NewTimer(d)
+				select {
+				case <-t.ctx.Done():
+					delay.Stop()
+					err = t.ctx.Err()
+					break TickLoop
+				case <-t.stop:
+					delay.Stop()
+					break TickLoop
+				case <-delay.C():
+				}
+			}
+		}
+
+		// any wake requests received so far will be satisfied by this tick
+		select {
+		case <-t.wake:
+		default:
+		}
+
+		last = t.clock.Now()
+		_, err = t.node.Tick()
+		t.stats.record(t.clock.Now().Sub(last))
+
+		if timer != nil {
+			if !timer.Stop() {
+				select {
+				case <-timeout:
+				default:
+				}
+			}
+			timer.Reset(t.max)
+		}
+	}
+
+	if timer != nil {
+		timer.Stop()
+	}
+	t.mutex.Lock()
+	t.err = err
+	t.mutex.Unlock()
+	t.Stop()
+	t.cancel()
+	wg.Wait()
+	close(t.done)
+}
+
+func (t *eventTicker) Wake() {
+	select {
+	case t.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (t *eventTicker) Done() <-chan struct{} {
+	return t.done
+}
+
+func (t *eventTicker) Err() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.err
+}
+
+func (t *eventTicker) Ticks() int64 {
+	return t.stats.ticks()
+}
+
+func (t *eventTicker) Stats() TickerStats {
+	return t.stats.snapshot()
+}
+
+func (t *eventTicker) Stop() {
+	t.once.Do(func() {
+		close(t.stop)
+	})
+}
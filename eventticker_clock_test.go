@@ -0,0 +1,89 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+	"github.com/joeycumines/go-behaviortree/bttest"
+)
+
+func TestNewEventTicker_clockMaxInterval(t *testing.T) {
+	var (
+		epoch = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		c     = bttest.NewClock(epoch)
+		ticks = make(chan time.Time)
+	)
+	ticker := bt.NewEventTicker(context.Background(), bt.New(func(children []bt.Node) (bt.Status, error) {
+		ticks <- c.Now()
+		return bt.Success, nil
+	}), bt.WithEventMaxInterval(time.Second), bt.WithClock(c))
+	for i := 1; i <= 3; i++ {
+		for c.Waiters() != 1 {
+			time.Sleep(time.Millisecond)
+		}
+		c.Advance(time.Second)
+		if v := <-ticks; !v.Equal(epoch.Add(time.Second * time.Duration(i))) {
+			t.Fatal(v)
+		}
+	}
+	ticker.Stop()
+	<-ticker.Done()
+	if err := ticker.Err(); err != nil {
+		t.Error(err)
+	}
+	if c.Waiters() != 0 {
+		t.Error(c.Waiters())
+	}
+}
+
+func TestNewEventTicker_clockMinInterval(t *testing.T) {
+	var (
+		epoch = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		c     = bttest.NewClock(epoch)
+		ticks = make(chan time.Time)
+	)
+	ticker := bt.NewEventTicker(context.Background(), bt.New(func(children []bt.Node) (bt.Status, error) {
+		ticks <- c.Now()
+		return bt.Success, nil
+	}), bt.WithEventMinInterval(time.Second), bt.WithClock(c))
+	ticker.Wake()
+	if v := <-ticks; !v.Equal(epoch) {
+		t.Fatal(v)
+	}
+	ticker.Wake()
+	for c.Waiters() != 1 {
+		time.Sleep(time.Millisecond)
+	}
+	c.Advance(time.Second)
+	if v := <-ticks; !v.Equal(epoch.Add(time.Second)) {
+		t.Fatal(v)
+	}
+	c.Advance(time.Second * 5)
+	ticker.Wake()
+	if v := <-ticks; !v.Equal(epoch.Add(time.Second * 6)) {
+		t.Fatal(v)
+	}
+	ticker.Stop()
+	<-ticker.Done()
+	if c.Waiters() != 0 {
+		t.Error(c.Waiters())
+	}
+}
@@ -0,0 +1,149 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestNewEventTicker_panic(t *testing.T) {
+	for _, tc := range []struct {
+		Name    string
+		Ctx     context.Context
+		Node    Node
+		Options []EventTickerOption
+		Panic   string
+	}{
+		{`nil context`, nil, New(Sequence), nil, `behaviortree.NewEventTicker nil context`},
+		{`nil node`, context.Background(), nil, nil, `behaviortree.NewEventTicker nil node`},
+		{`min > max`, context.Background(), New(Sequence), []EventTickerOption{WithEventMinInterval(2), WithEventMaxInterval(1)}, `behaviortree.NewEventTicker min interval > max interval`},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			defer func() {
+				if s := fmt.Sprint(recover()); s != tc.Panic {
+					t.Error(s)
+				}
+			}()
+			NewEventTicker(tc.Ctx, tc.Node, tc.Options...)
+			t.Error(`expected a panic`)
+		})
+	}
+}
+
+func TestNewEventTicker_wake(t *testing.T) {
+	var (
+		ticks   = make(chan struct{})
+		release = make(chan struct{})
+	)
+	ticker := NewEventTicker(context.Background(), New(func(children []Node) (Status, error) {
+		ticks <- struct{}{}
+		<-release
+		return Success, nil
+	}))
+	defer ticker.Stop()
+	select {
+	case <-ticks:
+		t.Fatal(`unexpected tick`)
+	case <-time.After(time.Millisecond * 50):
+	}
+	ticker.Wake()
+	<-ticks
+	// requests made while ticking are coalesced into a single tick
+	for i := 0; i < 5; i++ {
+		ticker.Wake()
+	}
+	release <- struct{}{}
+	<-ticks
+	release <- struct{}{}
+	select {
+	case <-ticks:
+		t.Fatal(`unexpected tick`)
+	case <-time.After(time.Millisecond * 50):
+	}
+	ticker.Stop()
+	<-ticker.Done()
+	if err := ticker.Err(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNewEventTicker_trigger(t *testing.T) {
+	var (
+		trigger = make(chan int)
+		closed  = make(chan string)
+		ticks   = make(chan struct{})
+	)
+	close(closed)
+	ticker := NewEventTicker(context.Background(), New(func(children []Node) (Status, error) {
+		ticks <- struct{}{}
+		return Success, nil
+	}), WithEventTrigger(trigger), WithEventTrigger[string](closed))
+	for i := 0; i < 3; i++ {
+		trigger <- i
+		<-ticks
+	}
+	ticker.Stop()
+	<-ticker.Done()
+	if err := ticker.Err(); err != nil {
+		t.Error(err)
+	}
+	select {
+	case trigger <- 0:
+		t.Error(`expected trigger to be abandoned`)
+	case <-time.After(time.Millisecond * 50):
+	}
+}
+
+func TestNewEventTicker_error(t *testing.T) {
+	expected := errors.New(`some_error`)
+	ticker := NewEventTicker(context.Background(), New(func(children []Node) (Status, error) {
+		return Failure, expected
+	}))
+	ticker.Wake()
+	<-ticker.Done()
+	if err := ticker.Err(); err != expected {
+		t.Error(err)
+	}
+}
+
+func TestNewEventTicker_cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ticker := NewEventTicker(ctx, New(Sequence))
+	cancel()
+	<-ticker.Done()
+	if err := ticker.Err(); err != context.Canceled {
+		t.Error(err)
+	}
+}
+
+func TestNewEventTicker_manager(t *testing.T) {
+	m := NewManager()
+	ticker := NewEventTicker(context.Background(), New(Sequence))
+	if err := m.Add(ticker); err != nil {
+		t.Fatal(err)
+	}
+	m.Stop()
+	<-m.Done()
+	<-ticker.Done()
+	if err := m.Err(); err != nil {
+		t.Error(err)
+	}
+}
@@ -472,3 +472,36 @@ func ExampleContext() {
 	//canceling parent then rechecking the above...
 	//exiting...
 }
+
+// ExampleTimeout demonstrates how Timeout may be used with Context, to cancel a long-running asynchronous tick
+func ExampleTimeout() {
+	var (
+		btCtx    = new(Context)
+		canceled = make(chan struct{})
+		ticker   = NewTicker(context.Background(), time.Millisecond, New(
+			Timeout(
+				time.Millisecond*50,
+				Async(btCtx.Tick(func(ctx context.Context, children []Node) (Status, error) {
+					defer close(canceled)
+					select {
+					case <-ctx.Done():
+						fmt.Println(`async tick canceled:`, ctx.Err())
+						return Failure, nil
+					case <-time.After(time.Second * 5):
+						return Success, nil
+					}
+				})),
+				WithTimeoutContext(btCtx),
+				WithTimeoutError(ErrTimeout),
+			),
+		))
+	)
+
+	<-ticker.Done()
+	<-canceled
+	fmt.Println(`ticker error:`, ticker.Err())
+
+	//output:
+	//async tick canceled: context canceled
+	//ticker error: behaviortree.Timeout deadline exceeded
+}
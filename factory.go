@@ -24,63 +24,33 @@ func defaultFactory(tick Tick, children []Node) Node {
 	if v := make([]uintptr, 1); runtimeCallers(3, v[:]) >= 1 {
 		if v, _ := runtimeCallersFrames(v).Next(); v.PC != 0 {
 			if children == nil {
-				return (&leafNodeFrame{tick: tick, frame: NewFrame(v)}).node
+				return newFrameNode(tick, nil, NewFrame(v))
 			}
-			return (&compositeNodeFrame{tick: tick, children: children, frame: NewFrame(v)}).node
+			return newFrameNode(tick, children, NewFrame(v))
 		}
 	}
-	if children == nil {
-		return leafNode(tick).node
-	}
-	return (&compositeNode{tick: tick, children: children}).node
+	return (&nodeDescriptor{tick: tick, children: children}).Node()
 }
 
-type leafNode Tick
-
-func (x leafNode) node() (Tick, []Node) { return Tick(x), nil }
-
-type leafNodeFrame struct {
-	tick  Tick
+// frameNode is a node with a frame, see also nodeDescriptor
+type frameNode struct {
+	nodeDescriptor
 	frame Frame
 }
 
-func (x *leafNodeFrame) Value(key any) (any, bool) {
-	if key == (vkFrame{}) {
-		frame := x.frame
-		return &frame, true
+func newFrameNode(tick Tick, children []Node, frame Frame) Node {
+	x := &frameNode{
+		nodeDescriptor: nodeDescriptor{tick: tick, children: children},
+		frame:          frame,
 	}
-	return nil, false
-}
-
-func (x *leafNodeFrame) node() (Tick, []Node) {
-	UseValueProvider(x)
-	return x.tick, nil
+	x.provider = x
+	return x.Node()
 }
 
-type compositeNode struct {
-	tick     Tick
-	children []Node
-}
-
-func (x *compositeNode) node() (Tick, []Node) {
-	return x.tick, x.children
-}
-
-type compositeNodeFrame struct {
-	tick     Tick
-	children []Node
-	frame    Frame
-}
-
-func (x *compositeNodeFrame) Value(key any) (any, bool) {
+func (x *frameNode) Value(key any) (any, bool) {
 	if key == (vkFrame{}) {
 		frame := x.frame
 		return &frame, true
 	}
 	return nil, false
 }
-
-func (x *compositeNodeFrame) node() (Tick, []Node) {
-	UseValueProvider(x)
-	return x.tick, x.children
-}
@@ -23,14 +23,15 @@ import (
 // Fork generates a stateful Tick which will tick all children at once, returning after all children return a result,
 // returning running if any children did so, and ticking only those which returned running in subsequent calls, until
 // all children have returned a non-running status, combining any errors, and returning success if there were no
-// failures or errors (otherwise failure), repeating this cycle for subsequent ticks
+// failures or errors (otherwise failure), repeating this cycle for subsequent ticks. Halting the node (see Node.Halt)
+// will end the current cycle, halting any children that were running.
 func Fork() Tick {
 	var (
 		remaining []Node
 		status    Status
 		err       error
 	)
-	return func(children []Node) (Status, error) {
+	return Tick(func(children []Node) (Status, error) {
 		if status == 0 && err == nil {
 			// cycle start
 			status = Success
@@ -73,5 +74,10 @@ func Fork() Tick {
 			return rs, re
 		}
 		return Running, nil
-	}
+	}).WithHalt(func([]Node) {
+		halt := remaining
+		remaining = nil
+		status, err = 0, nil
+		haltNodes(halt)
+	})
 }
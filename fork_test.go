@@ -19,6 +19,7 @@ package behaviortree
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -253,3 +254,27 @@ func TestFork_immediateError(t *testing.T) {
 		t.Fatal(status, err)
 	}
 }
+
+func TestFork_haltResets(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`a`: Success, `b`: Running, `c`: Running}}
+	node := New(Fork(), r.node(`a`), r.node(`b`), r.node(`c`))
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if v := r.takeSorted(); !reflect.DeepEqual(v, []string{`tick a`, `tick b`, `tick c`}) {
+		t.Error(v)
+	}
+	node.Halt()
+	if v := r.takeSorted(); !reflect.DeepEqual(v, []string{`halt b`, `halt c`}) {
+		t.Error(v)
+	}
+	// a new cycle, so a is ticked again
+	r.statuses[`b`] = Success
+	r.statuses[`c`] = Success
+	if status, err := node.Tick(); err != nil || status != Success {
+		t.Fatal(status, err)
+	}
+	if v := r.takeSorted(); !reflect.DeepEqual(v, []string{`tick a`, `tick b`, `tick c`}) {
+		t.Error(v)
+	}
+}
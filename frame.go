@@ -19,8 +19,12 @@ package behaviortree
 import (
 	"reflect"
 	"runtime"
+	"strings"
 )
 
+// packagePrefix is the prefix of the (qualified) names of the functions of this package, see Tick.Kind
+var packagePrefix = reflect.TypeOf(Node(nil)).PkgPath() + `.`
+
 // Frame is a partial copy of runtime.Frame.
 //
 // This packages captures details about the caller of it's New and NewNode functions, embedding them into the
@@ -119,11 +123,34 @@ func (n Node) Frame() *Frame {
 		v := *f
 		return &v
 	}
+	// approximate using the wrapped node, rather than any wrappers, e.g. Node.WithValue
+	for d := descriptorOf(n); d != nil && d.next != nil; d = descriptorOf(n) {
+		n = d.next
+	}
 	return newFrame(n)
 }
 
 // Frame will return an approximation of a call frame based on the receiver, or nil.
-func (t Tick) Frame() *Frame { return newFrame(t) }
+func (t Tick) Frame() *Frame {
+	// approximate using the wrapped tick, rather than any hook, see Tick.WithHalt
+	for x := haltableOf(t); x != nil; x = haltableOf(t) {
+		t = x.tick
+	}
+	return newFrame(t)
+}
+
+// Kind returns the unqualified name of the function that implemented the receiver, if it was provided by this
+// package, e.g. "Sequence", or "Memorize" (for the tick it returned), or an empty string, see also Tick.Frame.
+func (t Tick) Kind() string {
+	if frame := t.Frame(); frame != nil && strings.HasPrefix(frame.Function, packagePrefix) {
+		name := strings.TrimPrefix(frame.Function, packagePrefix)
+		if i := strings.IndexByte(name, '.'); i >= 0 {
+			name = name[:i]
+		}
+		return name
+	}
+	return ``
+}
 
 func newFrame(v any) (f *Frame) {
 	if v := reflect.ValueOf(v); v.IsValid() && v.Kind() == reflect.Func && !v.IsNil() {
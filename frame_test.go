@@ -35,8 +35,8 @@ func TestNode_Frame(t *testing.T) {
 			Name: `nn with value`,
 			Node: nn(nil, nil).WithValue(1, 2),
 			Frame: &Frame{
-				Function: `github.com/joeycumines/go-behaviortree.Node.WithValue.func1`,
-				File:     `value.go`,
+				Function: `github.com/joeycumines/go-behaviortree.nn.func1`,
+				File:     `value_test.go`,
 			},
 		},
 		{
@@ -108,6 +108,25 @@ func TestNode_Frame(t *testing.T) {
 	}
 }
 
+func TestTick_Kind(t *testing.T) {
+	for _, test := range []struct {
+		Name string
+		Tick Tick
+		Kind string
+	}{
+		{`nil`, nil, ``},
+		{`sequence`, Sequence, `Sequence`},
+		{`constructed`, Memorize(Sequence), `Memorize`},
+		{`halt hook`, Fork(), `Fork`},
+	} {
+		t.Run(test.Name, func(t *testing.T) {
+			if kind := test.Tick.Kind(); kind != test.Kind {
+				t.Error(kind)
+			}
+		})
+	}
+}
+
 func TestTick_Frame(t *testing.T) {
 	for _, test := range []struct {
 		Name  string
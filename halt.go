@@ -0,0 +1,160 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import "unsafe"
+
+type (
+	// vkHalt is the context key for Node.Halt
+	vkHalt struct{}
+
+	// haltableTick is a side channel, allowing Node.Halt to notify ticks of preemption, see Tick.WithHalt. As with
+	// nodeDescriptor, ticks with a hook are method values of haltableTick.run, and are recognised by haltableOf.
+	haltableTick struct {
+		tick Tick
+		halt func(children []Node)
+	}
+)
+
+// haltableTickPC is the code pointer of haltableTick.run method values, or 0 if they can't be recognised
+var haltableTickPC = func() uintptr {
+	x := new(haltableTick)
+	t := Tick(x.run)
+	if f := tickFuncValue(t); f != nil && f.fn != 0 && f.recv == unsafe.Pointer(x) {
+		return f.fn
+	}
+	return 0
+}()
+
+// GetHalt retrieves the halt hook from the Valuer, or nil if not present.
+//
+// This helper facilitates interoperability with external implementations of the [Valuer] interface.
+func GetHalt(n Valuer) func() {
+	v, _ := n.Value(vkHalt{}).(func())
+	return v
+}
+
+// WithHalt returns the value attachable with the halt hook attached.
+//
+// Passing a nil hook will attach a nil value, effectively clearing any previous hook.
+//
+// This helper facilitates interoperability with external implementations of the [ValueAttachable] interface.
+func WithHalt[T any](n ValueAttachable[T], fn func()) T {
+	if fn == nil {
+		return n.WithValue(vkHalt{}, nil)
+	}
+	return n.WithValue(vkHalt{}, fn)
+}
+
+// WithHalt returns a copy of the receiver, wrapped with the halt hook attached, to be called by Node.Halt.
+//
+// The hook will be called whenever the node is halted, which may occur even if it wasn't running, meaning it must be
+// idempotent. As with other values, if there are multiple hooks, only the outermost will be used.
+func (n Node) WithHalt(fn func()) Node {
+	return WithHalt[Node](n, fn)
+}
+
+type haltValueProvider func()
+
+func (p haltValueProvider) Value(key any) (any, bool) {
+	if key == (vkHalt{}) {
+		if p == nil {
+			return nil, true
+		}
+		return (func())(p), true
+	}
+	return nil, false
+}
+
+// UseHalt returns a [ValueProvider] that provides the given halt hook, intended to be registered (via
+// [UseValueProvider]) by custom node implementations that need to be notified when they are preempted.
+//
+// Passing a nil hook will provide a nil value, effectively clearing any previous hook.
+func UseHalt(fn func()) ValueProvider {
+	return haltValueProvider(fn)
+}
+
+// Halt notifies the receiver that it has been preempted, i.e. it may have returned running, on a previous tick, but
+// its parent has moved on without ticking it. Any hook attached via Node.WithHalt (or UseHalt) will be called, then
+// the children will be halted, via Tick.Halt, meaning stateful ticks (see Tick.WithHalt) may reset, and halt only
+// those children that were running. Halting a nil node is a noop.
+//
+// The built-in stateless composites (Sequence, Selector, All, Switch) don't halt the children they skip, see
+// HaltPreempted, and ReactiveSequence, for composites that do. Stateful ticks, such as Memorize, halt only the children
+// that were running. Halt hooks may still be called for nodes that were not running, and must therefore be idempotent.
+func (n Node) Halt() {
+	if n == nil {
+		return
+	}
+	if fn := GetHalt(n); fn != nil {
+		fn()
+	}
+	tick, children := n()
+	tick.Halt(children)
+}
+
+// WithHalt returns a copy of the receiver, with a hook attached, that will be called in place of halting each child,
+// when a node with the tick is halted (see Node.Halt), with the node's children. The hook is responsible for halting
+// any children that may be running, and may be used to reset any state, e.g. a decorator might reset, then call
+// Tick.Halt, on the tick it wraps. The receiver will be returned if it is nil, or fn is nil.
+func (t Tick) WithHalt(fn func(children []Node)) Tick {
+	if t == nil || fn == nil {
+		return t
+	}
+	return (&haltableTick{tick: t, halt: fn}).run
+}
+
+// Halt halts children, as the children of a node with the receiver as it's tick, calling any hook attached via
+// Tick.WithHalt, otherwise halting each child (see Node.Halt).
+func (t Tick) Halt(children []Node) {
+	if x := haltableOf(t); x != nil {
+		x.halt(children)
+		return
+	}
+	haltNodes(children)
+}
+
+// haltNodes halts each of the provided nodes, in order
+func haltNodes(nodes []Node) {
+	for _, node := range nodes {
+		node.Halt()
+	}
+}
+
+// decorate returns tick, which wraps inner, such that halting (see Node.Halt) will be delegated to inner, which is
+// only necessary if inner has a hook, see Tick.WithHalt
+func decorate(inner, tick Tick) Tick {
+	if haltableOf(inner) == nil {
+		return tick
+	}
+	return tick.WithHalt(inner.Halt)
+}
+
+func tickFuncValue(t Tick) *methodValue { return *(**methodValue)(unsafe.Pointer(&t)) }
+
+// haltableOf returns the haltableTick the tick was created from, or nil, in O(1)
+func haltableOf(t Tick) *haltableTick {
+	if haltableTickPC == 0 || t == nil {
+		return nil
+	}
+	if f := tickFuncValue(t); f.fn == haltableTickPC {
+		return (*haltableTick)(f.recv)
+	}
+	return nil
+}
+
+func (x *haltableTick) run(children []Node) (Status, error) { return x.tick(children) }
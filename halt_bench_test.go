@@ -0,0 +1,55 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"testing"
+)
+
+// buildSkipped constructs a composite, with the given tick, where the first child succeeds, and the remaining
+// children are sequences of n leaves, which are never ticked
+func buildSkipped(tick Tick, n int) Node {
+	leaves := make([]Node, n)
+	for i := range leaves {
+		leaves[i] = New(func([]Node) (Status, error) { return Running, nil })
+	}
+	return New(
+		tick,
+		New(func([]Node) (Status, error) { return Success, nil }),
+		New(Sequence, leaves...),
+		New(Sequence, leaves...),
+		New(Sequence, leaves...),
+	)
+}
+
+func benchmarkSkipped(b *testing.B, node Node) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if status, err := node.Tick(); err != nil || status != Success {
+			b.Fatal(status, err)
+		}
+	}
+}
+
+func BenchmarkSelector_skipped(b *testing.B) {
+	benchmarkSkipped(b, buildSkipped(Selector, 10))
+}
+
+func BenchmarkReactiveSelector_skipped(b *testing.B) {
+	benchmarkSkipped(b, buildSkipped(ReactiveSelector(), 10))
+}
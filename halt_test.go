@@ -0,0 +1,425 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+// haltRecorder builds leaf nodes that return a configurable status, recording ticks and halts by name
+type haltRecorder struct {
+	statuses map[string]Status
+	errs     map[string]error
+	mutex    sync.Mutex
+	events   []string
+}
+
+func (r *haltRecorder) node(name string) Node {
+	return New(func(children []Node) (Status, error) {
+		r.record(`tick ` + name)
+		if err := r.errs[name]; err != nil {
+			return Failure, err
+		}
+		return r.statuses[name], nil
+	}).WithHalt(func() { r.record(`halt ` + name) })
+}
+
+func (r *haltRecorder) record(event string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *haltRecorder) take() []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	events := r.events
+	r.events = nil
+	return events
+}
+
+// takeSorted is take, for children ticked concurrently
+func (r *haltRecorder) takeSorted() []string {
+	events := r.take()
+	sort.Strings(events)
+	return events
+}
+
+func TestNode_Halt_nil(t *testing.T) {
+	Node(nil).Halt()
+}
+
+func TestNode_Halt_recursive(t *testing.T) {
+	var events []string
+	hook := func(name string) func() { return func() { events = append(events, name) } }
+	node := New(
+		Sequence,
+		New(Sequence, New(nil).WithHalt(hook(`a`)), New(nil)).WithHalt(hook(`b`)),
+		New(nil).WithHalt(hook(`c`)),
+		nil,
+	).WithHalt(hook(`d`))
+	node.Halt()
+	if !reflect.DeepEqual(events, []string{`d`, `b`, `a`, `c`}) {
+		t.Error(events)
+	}
+}
+
+func TestNode_WithHalt_outermost(t *testing.T) {
+	var events []string
+	node := New(nil).
+		WithHalt(func() { events = append(events, `inner`) }).
+		WithHalt(func() { events = append(events, `outer`) })
+	node.Halt()
+	if !reflect.DeepEqual(events, []string{`outer`}) {
+		t.Error(events)
+	}
+	events = nil
+	node.WithHalt(nil).Halt()
+	if events != nil {
+		t.Error(events)
+	}
+	if v := GetHalt(node.WithHalt(nil)); v != nil {
+		t.Error(`expected nil`)
+	}
+}
+
+func TestUseHalt(t *testing.T) {
+	var count int
+	node := Node(func() (Tick, []Node) {
+		UseValueProvider(UseHalt(func() { count++ }))
+		return nil, nil
+	})
+	if GetHalt(node) == nil {
+		t.Fatal(`expected hook`)
+	}
+	node.Halt()
+	if count != 1 {
+		t.Error(count)
+	}
+	if v, ok := UseHalt(nil).Value(vkHalt{}); v != nil || !ok {
+		t.Error(v, ok)
+	}
+	if v, ok := UseHalt(func() {}).Value(vkName{}); v != nil || ok {
+		t.Error(v, ok)
+	}
+}
+
+func TestSequence_haltsNothing(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`a`: Success, `b`: Running, `c`: Success}}
+	node := New(Sequence, r.node(`a`), r.node(`b`), r.node(`c`))
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `tick b`}) {
+		t.Error(v)
+	}
+	r.statuses[`a`] = Failure
+	if status, err := node.Tick(); err != nil || status != Failure {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`}) {
+		t.Error(v)
+	}
+}
+
+func TestHaltPreempted_sequence(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`a`: Success, `b`: Running, `c`: Success}}
+	node := New(HaltPreempted(Sequence), r.node(`a`), r.node(`b`), r.node(`c`))
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `tick b`}) {
+		t.Error(v)
+	}
+	r.statuses[`a`] = Failure
+	if status, err := node.Tick(); err != nil || status != Failure {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `halt b`}) {
+		t.Error(v)
+	}
+	// b is no longer running, so there is nothing to halt
+	r.errs = map[string]error{`a`: errors.New(`some_error`)}
+	if status, err := node.Tick(); err == nil || status != Failure {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`}) {
+		t.Error(v)
+	}
+}
+
+func TestHaltPreempted_selector(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`a`: Failure, `b`: Running, `c`: Success}}
+	node := New(HaltPreempted(Selector), r.node(`a`), r.node(`b`), r.node(`c`))
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `tick b`}) {
+		t.Error(v)
+	}
+	r.statuses[`a`] = Success
+	if status, err := node.Tick(); err != nil || status != Success {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `halt b`}) {
+		t.Error(v)
+	}
+}
+
+func TestHaltPreempted_all(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`a`: Failure, `b`: Running, `c`: Success}}
+	node := New(HaltPreempted(All), r.node(`a`), r.node(`b`), r.node(`c`))
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `tick b`}) {
+		t.Error(v)
+	}
+	r.errs = map[string]error{`a`: errors.New(`some_error`)}
+	if status, err := node.Tick(); err == nil || status != Failure {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `halt b`}) {
+		t.Error(v)
+	}
+}
+
+func TestHaltPreempted_switch(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{
+		`c1`: Success, `s1`: Running,
+		`c2`: Failure, `s2`: Success,
+		`d`: Success,
+	}}
+	node := New(HaltPreempted(Switch), r.node(`c1`), r.node(`s1`), r.node(`c2`), r.node(`s2`), r.node(`d`))
+	for _, tc := range []struct {
+		Name   string
+		Update func()
+		Status Status
+		Err    bool
+		Events []string
+	}{
+		{
+			Name:   `first case`,
+			Update: func() {},
+			Status: Running,
+			Events: []string{`tick c1`, `tick s1`},
+		},
+		{
+			Name:   `default case`,
+			Update: func() { r.statuses[`c1`] = Failure },
+			Status: Success,
+			Events: []string{`tick c1`, `tick c2`, `tick d`, `halt s1`},
+		},
+		{
+			Name:   `running condition`,
+			Update: func() { r.statuses[`c2`] = Running },
+			Status: Running,
+			Events: []string{`tick c1`, `tick c2`},
+		},
+		{
+			Name:   `error condition`,
+			Update: func() { r.errs = map[string]error{`c1`: errors.New(`some_error`)} },
+			Err:    true,
+			Status: Failure,
+			Events: []string{`tick c1`, `halt c2`},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.Update()
+			status, err := node.Tick()
+			if status != tc.Status || (err != nil) != tc.Err {
+				t.Error(status, err)
+			}
+			if v := r.take(); !reflect.DeepEqual(v, tc.Events) {
+				t.Error(v)
+			}
+		})
+	}
+}
+
+// tickEach ticks every child, returning failure on the first failure, or otherwise running
+func tickEach(children []Node) (Status, error) {
+	for _, child := range children {
+		if status, err := child.Tick(); err != nil || status == Failure {
+			return Failure, err
+		}
+	}
+	return Running, nil
+}
+
+func TestHaltPreempted_halt(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`a`: Running, `b`: Running, `c`: Success}}
+	node := New(HaltPreempted(tickEach), r.node(`a`), r.node(`b`), r.node(`c`))
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	r.take()
+	node.Halt()
+	if v := r.take(); !reflect.DeepEqual(v, []string{`halt a`, `halt b`}) {
+		t.Error(v)
+	}
+	node.Halt()
+	if v := r.take(); v != nil {
+		t.Error(v)
+	}
+	if HaltPreempted(nil) != nil {
+		t.Error(`expected nil`)
+	}
+	if kind := HaltPreempted(Sequence).Kind(); kind != `HaltPreempted` {
+		t.Error(kind)
+	}
+}
+
+func TestHaltPreempted_childrenChange(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`a`: Running, `b`: Running}}
+	children := []Node{r.node(`a`), r.node(`b`)}
+	tick := HaltPreempted(tickEach)
+	node := Node(func() (Tick, []Node) { return tick, children })
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	r.take()
+	// running state is retained by index
+	children = []Node{children[0], children[1], r.node(`c`)}
+	r.statuses[`a`] = Failure
+	if status, err := node.Tick(); err != nil || status != Failure {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `halt b`}) {
+		t.Error(v)
+	}
+}
+
+func TestTick_WithHalt(t *testing.T) {
+	var events []string
+	tick := Tick(func([]Node) (Status, error) { return Running, nil })
+	if tick.WithHalt(nil) == nil || Tick(nil).WithHalt(func([]Node) {}) != nil {
+		t.Fatal(`unexpected result`)
+	}
+	hooked := tick.WithHalt(func(children []Node) { events = append(events, `hook`) })
+	if status, err := hooked(nil); err != nil || status != Running {
+		t.Error(status, err)
+	}
+	node := New(hooked, New(nil).WithHalt(func() { events = append(events, `child`) }))
+	node.Halt()
+	if !reflect.DeepEqual(events, []string{`hook`}) {
+		t.Error(events)
+	}
+	events = nil
+	New(Not(hooked)).Halt()
+	if !reflect.DeepEqual(events, []string{`hook`}) {
+		t.Error(events)
+	}
+	if f, g := hooked.Frame(), tick.Frame(); f == nil || g == nil || f.Function != g.Function {
+		t.Error(f, g)
+	}
+}
+
+func TestMemorize_halt(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`a`: Running, `b`: Running}}
+	var done bool
+	node := New(
+		Memorize(func(children []Node) (Status, error) {
+			for _, child := range children {
+				if _, err := child.Tick(); err != nil {
+					return Failure, err
+				}
+			}
+			if done {
+				return Success, nil
+			}
+			return Running, nil
+		}),
+		r.node(`a`),
+		r.node(`b`),
+	)
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `tick b`}) {
+		t.Error(v)
+	}
+	r.statuses[`a`] = Success
+	done = true
+	if status, err := node.Tick(); err != nil || status != Success {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `tick b`, `halt b`}) {
+		t.Error(v)
+	}
+	done = false
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `tick b`}) {
+		t.Error(v)
+	}
+}
+
+func TestMemorize_haltResets(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`a`: Success, `b`: Running}}
+	node := New(Memorize(Sequence), r.node(`a`), r.node(`b`))
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `tick b`}) {
+		t.Error(v)
+	}
+	node.Halt()
+	if v := r.take(); !reflect.DeepEqual(v, []string{`halt b`}) {
+		t.Error(v)
+	}
+	// a new execution, so a is ticked again
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `tick b`}) {
+		t.Error(v)
+	}
+}
+
+func TestMemorize_haltDelegates(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`a`: Running, `b`: Running}}
+	var halted []Node
+	node := New(
+		Memorize(Tick(func(children []Node) (Status, error) {
+			for _, child := range children {
+				if _, err := child.Tick(); err != nil {
+					return Failure, err
+				}
+			}
+			return Running, nil
+		}).WithHalt(func(children []Node) { halted = children })),
+		r.node(`a`),
+		r.node(`b`),
+	)
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	r.take()
+	node.Halt()
+	if len(halted) != 2 {
+		t.Error(halted)
+	}
+	if v := r.take(); v != nil {
+		t.Error(v)
+	}
+}
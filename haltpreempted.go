@@ -0,0 +1,148 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import "sync"
+
+type (
+	// preemption implements HaltPreempted
+	preemption struct {
+		tick  Tick
+		mutex sync.Mutex
+		// src are the children the encapsulated nodes were built for
+		src   []Node
+		nodes []Node
+		state *preemptionState
+	}
+
+	// preemptionState is per encapsulation, in case any encapsulated children outlive it
+	preemptionState struct {
+		// running indicates if each child returned running, the last time it was ticked
+		running []bool
+		// ticked indicates if each child has been ticked, during the current tick
+		ticked []bool
+	}
+
+	// preemptedChild encapsulates a child, for preemption
+	preemptedChild struct {
+		p     *preemption
+		state *preemptionState
+		i     int
+		node  Node
+		tick  Tick
+		run   Tick
+	}
+)
+
+// HaltPreempted wraps a tick, typically a stateless composite, such as Sequence, Selector, All, or Switch, such that
+// any child that returned running, the last time it was ticked, but wasn't ticked this time, will be halted (see
+// Node.Halt), after the wrapped tick returns. This is achieved by encapsulation of children, in the same manner as
+// Memorize, and, like Memorize, halting the node will halt any children that were running. Nil will be returned if
+// tick is nil.
+//
+// See also ReactiveSequence and ReactiveSelector.
+func HaltPreempted(tick Tick) Tick {
+	if tick == nil {
+		return nil
+	}
+	p := &preemption{tick: tick}
+	return Tick(func(children []Node) (Status, error) { return p.run(children) }).WithHalt(p.halt)
+}
+
+func (p *preemption) run(children []Node) (Status, error) {
+	p.encapsulate(children)
+	status, err := p.tick(p.nodes)
+	var halt []Node
+	p.mutex.Lock()
+	for i, ticked := range p.state.ticked {
+		if ticked {
+			p.state.ticked[i] = false
+		} else if p.state.running[i] {
+			p.state.running[i] = false
+			halt = append(halt, children[i])
+		}
+	}
+	p.mutex.Unlock()
+	haltNodes(halt)
+	return status, err
+}
+
+// encapsulate (re)builds the encapsulated nodes, if children has changed, retaining any state, by index
+func (p *preemption) encapsulate(children []Node) {
+	if p.state != nil && len(children) == len(p.src) && (len(children) == 0 || &children[0] == &p.src[0]) {
+		return
+	}
+	state := &preemptionState{
+		running: make([]bool, len(children)),
+		ticked:  make([]bool, len(children)),
+	}
+	if p.state != nil {
+		p.mutex.Lock()
+		copy(state.running, p.state.running)
+		p.mutex.Unlock()
+	}
+	p.src = children
+	p.nodes = make([]Node, len(children))
+	p.state = state
+	for i, child := range children {
+		if child == nil {
+			continue
+		}
+		x := &preemptedChild{p: p, state: state, i: i, node: child}
+		x.run = x.tickChild
+		p.nodes[i] = x.expand
+	}
+}
+
+// halt halts any children that were running, delegating to the wrapped tick, if it has a hook (see Tick.WithHalt)
+func (p *preemption) halt(children []Node) {
+	var halt []Node
+	if p.state != nil {
+		p.mutex.Lock()
+		for i, running := range p.state.running {
+			if running && i < len(children) {
+				halt = append(halt, children[i])
+			}
+			p.state.running[i] = false
+			p.state.ticked[i] = false
+		}
+		p.mutex.Unlock()
+	}
+	if haltableOf(p.tick) != nil {
+		p.tick.Halt(children)
+		return
+	}
+	haltNodes(halt)
+}
+
+func (x *preemptedChild) expand() (Tick, []Node) {
+	tick, children := x.node()
+	if tick == nil {
+		return nil, children
+	}
+	x.tick = tick
+	return x.run, children
+}
+
+func (x *preemptedChild) tickChild(children []Node) (Status, error) {
+	status, err := x.tick(children)
+	x.p.mutex.Lock()
+	x.state.ticked[x.i] = true
+	x.state.running[x.i] = err == nil && status == Running
+	x.p.mutex.Unlock()
+	return status, err
+}
@@ -0,0 +1,114 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package bttrace implements the tracing shared by the btdebug and btrecord packages, identifying each node by the
+// path of child indexes from the root, see PathID.
+package bttrace
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+)
+
+type (
+	// Tracer implements bt.Tracer, identifying each node by it's ID (see PathID), which is carried via the context
+	Tracer struct {
+		// Start will be called (if non-nil) prior to each tick, with the ID of the node
+		Start func(id string)
+		// End will be called (if non-nil) after each tick, with the ID of the node, and the result
+		End func(id string, status bt.Status, err error, elapsed time.Duration)
+	}
+
+	// Option configures the clock, see WithClock
+	Option interface {
+		Apply(c *Config)
+	}
+
+	// Config is the configuration of the packages using this one, see Option
+	Config struct {
+		Clock bt.Clock
+	}
+
+	optionFunc func(c *Config)
+
+	// vkID is the context key for the ID of the node, see Tracer
+	vkID struct{}
+)
+
+// RootID is the ID of the root node, see PathID
+const RootID = `0`
+
+var (
+	_ bt.Tracer = Tracer{}
+)
+
+// PathID returns the ID of the node at the given path, e.g. "0.1.0" is the first child of the second child of the
+// root, which is "0"
+func PathID(path []int) string {
+	var b strings.Builder
+	b.WriteString(RootID)
+	for _, i := range path {
+		b.WriteByte('.')
+		b.WriteString(strconv.Itoa(i))
+	}
+	return b.String()
+}
+
+// ChildID returns the ID of the i-th child of the node with the given ID, see PathID
+func ChildID(id string, i int) string {
+	return id + `.` + strconv.Itoa(i)
+}
+
+// OnTickStart implements bt.Tracer, calling Start, and carrying the ID of the node via the context
+func (x Tracer) OnTickStart(ctx context.Context, _ bt.Node, path []int) context.Context {
+	id := PathID(path)
+	if x.Start != nil {
+		x.Start(id)
+	}
+	return context.WithValue(ctx, vkID{}, id)
+}
+
+// OnTickEnd implements bt.Tracer, calling End
+func (x Tracer) OnTickEnd(ctx context.Context, _ bt.Node, status bt.Status, err error, elapsed time.Duration) {
+	if x.End != nil {
+		id, _ := ctx.Value(vkID{}).(string)
+		x.End(id, status, err, elapsed)
+	}
+}
+
+// NewConfig returns the configuration, with options applied, where the clock defaults to bt.DefaultClock
+func NewConfig(options []Option) Config {
+	var c Config
+	for _, o := range options {
+		o.Apply(&c)
+	}
+	if c.Clock == nil {
+		c.Clock = bt.DefaultClock
+	}
+	return c
+}
+
+// WithClock returns an option that configures the clock, see Config
+func WithClock(clock bt.Clock) Option {
+	return optionFunc(func(c *Config) { c.Clock = clock })
+}
+
+// Apply implements Option
+func (f optionFunc) Apply(c *Config) { f(c) }
@@ -0,0 +1,83 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package bttrace
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+	"github.com/joeycumines/go-behaviortree/bttest"
+)
+
+func TestPathID(t *testing.T) {
+	for _, tc := range []struct {
+		path []int
+		id   string
+	}{
+		{nil, `0`},
+		{[]int{1}, `0.1`},
+		{[]int{1, 0, 12}, `0.1.0.12`},
+	} {
+		if v := PathID(tc.path); v != tc.id {
+			t.Error(tc.path, v)
+		}
+		if n := len(tc.path); n != 0 {
+			if v := ChildID(PathID(tc.path[:n-1]), tc.path[n-1]); v != tc.id {
+				t.Error(tc.path, v)
+			}
+		}
+	}
+}
+
+func TestTracer(t *testing.T) {
+	var events []string
+	leaf := bt.New(func([]bt.Node) (bt.Status, error) { return bt.Success, nil })
+	node := bt.Trace(bt.New(bt.Sequence, leaf, bt.New(bt.Sequence, leaf)), Tracer{
+		Start: func(id string) { events = append(events, `start `+id) },
+		End: func(id string, status bt.Status, err error, _ time.Duration) {
+			events = append(events, fmt.Sprintf(`end %s %s %v`, id, status, err))
+		},
+	})
+	if status, err := node.Tick(); err != nil || status != bt.Success {
+		t.Fatal(status, err)
+	}
+	if !reflect.DeepEqual(events, []string{
+		`start 0`,
+		`start 0.0`,
+		`end 0.0 success <nil>`,
+		`start 0.1`,
+		`start 0.1.0`,
+		`end 0.1.0 success <nil>`,
+		`end 0.1 success <nil>`,
+		`end 0 success <nil>`,
+	}) {
+		t.Errorf("%q", events)
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	if c := NewConfig(nil); c.Clock != bt.DefaultClock {
+		t.Error(c)
+	}
+	clock := bttest.NewClock(time.Unix(0, 0))
+	if c := NewConfig([]Option{WithClock(clock)}); c.Clock != clock {
+		t.Error(c)
+	}
+}
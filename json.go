@@ -0,0 +1,115 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sync"
+)
+
+type (
+	// treeJSON is the JSON representation of a node, see MarshalTree
+	treeJSON struct {
+		Name     string         `json:"name,omitempty"`
+		Kind     string         `json:"kind,omitempty"`
+		Frame    *frameJSON     `json:"frame,omitempty"`
+		Metadata map[string]any `json:"metadata,omitempty"`
+		Children []*treeJSON    `json:"children,omitempty"`
+	}
+
+	frameJSON struct {
+		Function string `json:"function,omitempty"`
+		File     string `json:"file,omitempty"`
+		Line     int    `json:"line,omitempty"`
+	}
+
+	treeValue struct {
+		name string
+		key  any
+	}
+)
+
+var (
+	treeValuesMutex sync.RWMutex
+	treeValues      []treeValue
+)
+
+// RegisterTreeValue registers a value key, as used with Node.WithValue (or UseValueProvider), to be included by
+// MarshalTree, under the given name, within the metadata of each node with a non-nil value, which must be
+// serializable using encoding/json. A panic will occur if the name is empty or already registered, or if the key is
+// nil or not comparable. This function is intended to be called during initialisation.
+func RegisterTreeValue(name string, key any) {
+	if name == `` {
+		panic(errors.New(`behaviortree.RegisterTreeValue empty name`))
+	}
+	if key == nil {
+		panic(errors.New(`behaviortree.RegisterTreeValue nil key`))
+	}
+	if !reflect.TypeOf(key).Comparable() {
+		panic(errors.New(`behaviortree.RegisterTreeValue key is not comparable`))
+	}
+	treeValuesMutex.Lock()
+	defer treeValuesMutex.Unlock()
+	for _, v := range treeValues {
+		if v.name == name {
+			panic(errors.New(`behaviortree.RegisterTreeValue duplicate name: ` + name))
+		}
+	}
+	treeValues = append(treeValues, treeValue{name: name, key: key})
+}
+
+// MarshalTree encodes the logical structure of the tree (see Walk) as JSON, where each node is an object with the
+// (optional) fields "name" (see GetName), "kind" (the tick, if provided by this package, e.g. "Sequence"), "frame"
+// (with the "function", "file", and "line", see GetFrame and Node.Frame), "metadata" (see RegisterTreeValue), and
+// "children" (an array, which may contain null, for nil nodes). A nil tree will be encoded as null.
+//
+// Note that this function uses the Value mechanism, and is subject to the same performance limitations.
+func MarshalTree(n Metadata) ([]byte, error) {
+	treeValuesMutex.RLock()
+	values := treeValues
+	treeValuesMutex.RUnlock()
+	return json.Marshal(buildTreeJSON(n, values))
+}
+
+func buildTreeJSON(n Metadata, values []treeValue) *treeJSON {
+	if isNilMetadata(n) {
+		return nil
+	}
+	result := treeJSON{Name: GetName(n)}
+	if node, ok := n.(Node); ok {
+		tick, _ := node()
+		result.Kind = tick.Kind()
+	}
+	if frame := metadataFrame(n); frame != nil {
+		result.Frame = &frameJSON{Function: frame.Function, File: frame.File, Line: frame.Line}
+	}
+	for _, v := range values {
+		if value := n.Value(v.key); value != nil {
+			if result.Metadata == nil {
+				result.Metadata = make(map[string]any)
+			}
+			result.Metadata[v.name] = value
+		}
+	}
+	n.Children(func(child Metadata) bool {
+		result.Children = append(result.Children, buildTreeJSON(child, values))
+		return true
+	})
+	return &result
+}
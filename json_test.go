@@ -0,0 +1,111 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type (
+	vkJSONTestPriority struct{}
+	vkJSONTestInvalid  struct{}
+)
+
+func init() {
+	RegisterTreeValue(`test.priority`, vkJSONTestPriority{})
+	RegisterTreeValue(`test.invalid`, vkJSONTestInvalid{})
+}
+
+func TestMarshalTree(t *testing.T) {
+	b, err := MarshalTree(newDiagramTestTree().WithValue(vkJSONTestPriority{}, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v struct {
+		Name     string         `json:"name"`
+		Kind     string         `json:"kind"`
+		Frame    frameJSON      `json:"frame"`
+		Metadata map[string]int `json:"metadata"`
+		Children []*treeJSON    `json:"children"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Name != `root` || v.Kind != `Selector` || v.Metadata[`test.priority`] != 3 || len(v.Metadata) != 1 {
+		t.Error(string(b))
+	}
+	if !strings.HasSuffix(v.Frame.File, `diagram_test.go`) || v.Frame.Line == 0 || v.Frame.Function == `` {
+		t.Error(v.Frame)
+	}
+	if len(v.Children) != 3 || v.Children[1] != nil {
+		t.Fatal(string(b))
+	}
+	virtual := v.Children[0]
+	if virtual.Name != `virtual` || virtual.Kind != `Sequence` || virtual.Metadata != nil || len(virtual.Children) != 2 {
+		t.Error(string(b))
+	}
+	if c := virtual.Children[0]; c.Name != `say "hi"` || c.Kind != `` || c.Frame != nil || c.Children != nil {
+		t.Error(c)
+	}
+	if c := virtual.Children[1]; c.Frame == nil || c.Frame.Function != `example.com/pkg.Step` || c.Frame.File != `` || len(c.Children) != 2 {
+		t.Error(c)
+	}
+	if c := v.Children[2]; c.Name != `leaf` || c.Children != nil {
+		t.Error(c)
+	}
+}
+
+func TestMarshalTree_nil(t *testing.T) {
+	if b, err := MarshalTree(nil); err != nil || string(b) != `null` {
+		t.Error(string(b), err)
+	}
+	if b, err := MarshalTree(Node(nil)); err != nil || string(b) != `null` {
+		t.Error(string(b), err)
+	}
+}
+
+func TestMarshalTree_error(t *testing.T) {
+	if _, err := MarshalTree(New(Sequence).WithValue(vkJSONTestInvalid{}, func() {})); err == nil {
+		t.Error(`expected error`)
+	}
+}
+
+func TestRegisterTreeValue_panic(t *testing.T) {
+	for _, tc := range []struct {
+		Name  string
+		Key   any
+		Panic string
+	}{
+		{``, vkJSONTestPriority{}, `behaviortree.RegisterTreeValue empty name`},
+		{`a`, nil, `behaviortree.RegisterTreeValue nil key`},
+		{`a`, []int{}, `behaviortree.RegisterTreeValue key is not comparable`},
+		{`test.priority`, vkJSONTestPriority{}, `behaviortree.RegisterTreeValue duplicate name: test.priority`},
+	} {
+		t.Run(tc.Panic, func(t *testing.T) {
+			defer func() {
+				if s := fmt.Sprint(recover()); s != tc.Panic {
+					t.Error(s)
+				}
+			}()
+			RegisterTreeValue(tc.Name, tc.Key)
+			t.Error(`expected a panic`)
+		})
+	}
+}
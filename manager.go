@@ -18,8 +18,11 @@ package behaviortree
 
 import (
 	"errors"
+	"fmt"
 	"github.com/joeycumines/go-bigbuff"
+	"slices"
 	"sync"
+	"time"
 )
 
 type (
@@ -31,20 +34,70 @@ type (
 		Add(ticker Ticker) error
 	}
 
-	// manager is this package's implementation of the Manager interface
+	// NamedManager extends Manager with support for named tickers, and is implemented by NewManager
+	NamedManager interface {
+		Manager
+
+		// AddNamed will register a new ticker under this manager, like Add, but with a name, which must be unique
+		// among the named tickers, allowing it to be inspected via List or Get, and removed via Remove
+		AddNamed(name string, ticker Ticker) error
+
+		// List returns the state of each named ticker, in the order they were added, including any that have
+		// stopped, but not been removed
+		List() []TickerInfo
+
+		// Get returns the state of the named ticker, or false if there is no such ticker
+		Get(name string) (TickerInfo, bool)
+
+		// Remove will stop the named ticker, without stopping the manager, and unregister the name, returning false
+		// if there is no such ticker, note that any error from a removed ticker will be ignored
+		Remove(name string) bool
+	}
+
+	// TickerInfo models the state of a named ticker, registered with a NamedManager
+	TickerInfo struct {
+		// Name is the name the ticker was registered with
+		Name string
+		// Ticker is the registered ticker
+		Ticker Ticker
+		// Running will be true until the ticker is done
+		Running bool
+		// Err is the error of the ticker, see Ticker.Err
+		Err error
+		// Started is when the ticker was registered
+		Started time.Time
+		// Ticks is the number of ticks, if the ticker implements TickCounter, otherwise -1
+		Ticks int64
+	}
+
+	// ManagerOption configures the behavior of NewManager, see also WithClock
+	ManagerOption interface {
+		applyManager(c *managerConfig)
+	}
+
+	managerConfig struct {
+		clock Clock
+	}
+
+	// manager is this package's implementation of the Manager (and NamedManager) interface
 	manager struct {
 		mu      sync.RWMutex
 		once    sync.Once
 		worker  bigbuff.Worker
+		clock   Clock
 		done    chan struct{}
 		stop    chan struct{}
-		tickers chan managerTicker
+		tickers chan *managerTicker
 		errs    []error
+		named   []*managerTicker
 	}
 
 	managerTicker struct {
-		Ticker Ticker
-		Done   func()
+		Ticker  Ticker
+		Done    func()
+		name    string
+		started time.Time
+		removed bool
 	}
 
 	errManagerTicker []error
@@ -58,21 +111,30 @@ var (
 	ErrManagerStopped error = errManagerStopped{error: errors.New(`behaviortree.Manager.Add already stopped`)}
 )
 
-// NewManager will construct an implementation of the Manager interface, which is a stateful set of Ticker
+// NewManager will construct an implementation of the NamedManager interface, which is a stateful set of Ticker
 // implementations, aggregating the behavior such that the Done channel will close when ALL tickers registered with Add
 // are done, Err will return a combined error if there are any, and Stop will stop all registered tickers.
 //
 // Note that any error (of any registered tickers) will also trigger stopping, and stopping will prevent further
-// Add calls from succeeding.
+// Add calls from succeeding. Tickers that should be restarted, rather than stopping the manager, may be wrapped using
+// Supervise.
+//
+// Tickers may also be registered with a name, via NamedManager.AddNamed, allowing them to be listed, inspected, and
+// individually removed (stopped), see also TickerInfo.
 //
 // As of v1.8.0, any (combined) ticker error returned by the Manager can now support error chaining (i.e. the use of
 // errors.Is). Note that errors.Unwrap isn't supported, since there may be more than one. See also Manager.Err and
 // Manager.Add.
-func NewManager() Manager {
+func NewManager(options ...ManagerOption) NamedManager {
+	var c managerConfig
+	for _, o := range options {
+		o.applyManager(&c)
+	}
 	result := &manager{
+		clock:   orDefaultClock(c.clock),
 		done:    make(chan struct{}),
 		stop:    make(chan struct{}),
-		tickers: make(chan managerTicker),
+		tickers: make(chan *managerTicker),
 	}
 	return result
 }
@@ -101,16 +163,91 @@ func (m *manager) Add(ticker Ticker) error {
 	if ticker == nil {
 		return errors.New("behaviortree.Manager.Add nil ticker")
 	}
+	return m.add(&managerTicker{Ticker: ticker})
+}
+
+func (m *manager) AddNamed(name string, ticker Ticker) error {
+	if name == "" {
+		return errors.New("behaviortree.Manager.AddNamed empty name")
+	}
+	if ticker == nil {
+		return errors.New("behaviortree.Manager.AddNamed nil ticker")
+	}
+	t := &managerTicker{
+		Ticker:  ticker,
+		name:    name,
+		started: m.clock.Now(),
+	}
+	m.mu.Lock()
+	if m.index(name) != -1 {
+		m.mu.Unlock()
+		return fmt.Errorf("behaviortree.Manager.AddNamed duplicate name: %q", name)
+	}
+	m.named = append(m.named, t)
+	m.mu.Unlock()
+	if err := m.add(t); err != nil {
+		m.mu.Lock()
+		m.named = slices.DeleteFunc(m.named, func(v *managerTicker) bool { return v == t })
+		m.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+func (m *manager) List() []TickerInfo {
+	m.mu.RLock()
+	named := slices.Clone(m.named)
+	m.mu.RUnlock()
+	result := make([]TickerInfo, len(named))
+	for i, t := range named {
+		result[i] = t.info()
+	}
+	return result
+}
+
+func (m *manager) Get(name string) (TickerInfo, bool) {
+	m.mu.RLock()
+	i := m.index(name)
+	var t *managerTicker
+	if i != -1 {
+		t = m.named[i]
+	}
+	m.mu.RUnlock()
+	if t == nil {
+		return TickerInfo{}, false
+	}
+	return t.info(), true
+}
+
+func (m *manager) Remove(name string) bool {
+	m.mu.Lock()
+	i := m.index(name)
+	if i == -1 {
+		m.mu.Unlock()
+		return false
+	}
+	t := m.named[i]
+	t.removed = true
+	m.named = slices.Delete(m.named, i, i+1)
+	m.mu.Unlock()
+	t.Ticker.Stop()
+	return true
+}
+
+// index returns the index of the named ticker, or -1, and must be called with the lock held
+func (m *manager) index(name string) int {
+	return slices.IndexFunc(m.named, func(t *managerTicker) bool { return t.name == name })
+}
+
+func (m *manager) add(t *managerTicker) error {
 	done := m.start()
+	t.Done = done
 	select {
 	case <-m.stop:
 	default:
 		select {
 		case <-m.stop:
-		case m.tickers <- managerTicker{
-			Ticker: ticker,
-			Done:   done,
-		}:
+		case m.tickers <- t:
 			return nil
 		}
 	}
@@ -143,7 +280,7 @@ func (m *manager) run(stop <-chan struct{}) {
 	}
 }
 
-func (m *manager) handle(t managerTicker) {
+func (m *manager) handle(t *managerTicker) {
 	select {
 	case <-t.Ticker.Done():
 		// note: this stop shouldn't be necessary, but has been retained for
@@ -155,13 +292,38 @@ func (m *manager) handle(t managerTicker) {
 	}
 	if err := t.Ticker.Err(); err != nil {
 		m.mu.Lock()
-		m.errs = append(m.errs, err)
+		removed := t.removed
+		if !removed {
+			m.errs = append(m.errs, err)
+		}
 		m.mu.Unlock()
-		m.Stop()
+		if !removed {
+			m.Stop()
+		}
 	}
 	t.Done()
 }
 
+func (t *managerTicker) info() TickerInfo {
+	result := TickerInfo{
+		Name:    t.name,
+		Ticker:  t.Ticker,
+		Running: true,
+		Err:     t.Ticker.Err(),
+		Started: t.started,
+		Ticks:   -1,
+	}
+	select {
+	case <-t.Ticker.Done():
+		result.Running = false
+	default:
+	}
+	if v, ok := t.Ticker.(TickCounter); ok {
+		result.Ticks = v.Ticks()
+	}
+	return result
+}
+
 func (e errManagerTicker) Error() string {
 	var b []byte
 	for i, err := range e {
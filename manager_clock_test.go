@@ -0,0 +1,60 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+	"github.com/joeycumines/go-behaviortree/bttest"
+)
+
+func TestNewManager_clock(t *testing.T) {
+	var (
+		epoch = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		c     = bttest.NewClock(epoch)
+		ticks = make(chan struct{})
+		m     = bt.NewManager(bt.WithClock(c))
+	)
+	c.Advance(time.Minute)
+	ticker := bt.NewTicker(context.Background(), time.Second, bt.New(func(children []bt.Node) (bt.Status, error) {
+		ticks <- struct{}{}
+		return bt.Success, nil
+	}), bt.WithClock(c))
+	if err := m.AddNamed(`a`, ticker); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		c.Advance(time.Second)
+		<-ticks
+	}
+	for {
+		if info, ok := m.Get(`a`); !ok || !info.Running || !info.Started.Equal(epoch.Add(time.Minute)) {
+			t.Fatal(info, ok)
+		} else if info.Ticks == 2 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	m.Stop()
+	<-m.Done()
+	if info, ok := m.Get(`a`); !ok || info.Running || info.Ticks != 2 {
+		t.Error(info, ok)
+	}
+}
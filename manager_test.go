@@ -355,6 +355,148 @@ func TestNewManager(t *testing.T) {
 	}
 }
 
+func TestManager_AddNamed(t *testing.T) {
+	defer checkNumGoroutines(t)(false, 0)
+	m := NewManager()
+	if err := m.AddNamed(``, blockingTicker()); err == nil || err.Error() != `behaviortree.Manager.AddNamed empty name` {
+		t.Error(err)
+	}
+	if err := m.AddNamed(`a`, nil); err == nil || err.Error() != `behaviortree.Manager.AddNamed nil ticker` {
+		t.Error(err)
+	}
+	a, b := blockingTicker(), blockingTicker()
+	if err := m.AddNamed(`a`, a); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.AddNamed(`b`, b); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.AddNamed(`a`, blockingTicker()); err == nil || err.Error() != `behaviortree.Manager.AddNamed duplicate name: "a"` {
+		t.Error(err)
+	}
+	if err := m.Add(blockingTicker()); err != nil {
+		t.Fatal(err)
+	}
+
+	list := m.List()
+	if len(list) != 2 || list[0].Name != `a` || list[0].Ticker.Done() != a.Done() || list[1].Name != `b` || list[1].Ticker.Done() != b.Done() {
+		t.Fatal(list)
+	}
+	for _, info := range list {
+		if !info.Running || info.Err != nil || info.Started.IsZero() || info.Ticks != -1 {
+			t.Error(info)
+		}
+	}
+
+	if _, ok := m.Get(`c`); ok {
+		t.Error(`unexpected ticker`)
+	}
+	if m.Remove(`c`) {
+		t.Error(`unexpected remove`)
+	}
+	if !m.Remove(`a`) {
+		t.Fatal(`expected remove`)
+	}
+	if m.Remove(`a`) {
+		t.Error(`unexpected remove`)
+	}
+	<-a.Done()
+	if _, ok := m.Get(`a`); ok {
+		t.Error(`unexpected ticker`)
+	}
+	if info, ok := m.Get(`b`); !ok || info.Name != `b` || !info.Running {
+		t.Error(info, ok)
+	}
+	if list := m.List(); len(list) != 1 || list[0].Name != `b` {
+		t.Error(list)
+	}
+
+	// the name may be reused, once removed
+	if err := m.AddNamed(`a`, blockingTicker()); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-m.Done():
+		t.Fatal(`unexpected done`)
+	default:
+	}
+	m.Stop()
+	<-m.Done()
+	if err := m.Err(); err != nil {
+		t.Error(err)
+	}
+	for _, info := range m.List() {
+		if info.Running {
+			t.Error(info)
+		}
+	}
+	if err := m.AddNamed(`c`, blockingTicker()); err != ErrManagerStopped {
+		t.Error(err)
+	}
+	if _, ok := m.Get(`c`); ok {
+		t.Error(`unexpected ticker`)
+	}
+}
+
+func TestManager_Remove_ignoresError(t *testing.T) {
+	defer checkNumGoroutines(t)(false, 0)
+	var (
+		m    = NewManager()
+		once sync.Once
+		done = make(chan struct{})
+	)
+	if err := m.AddNamed(`a`, mockTicker{
+		done: func() <-chan struct{} { return done },
+		err: func() error {
+			select {
+			case <-done:
+				return errors.New(`some error`)
+			default:
+				return nil
+			}
+		},
+		stop: func() { once.Do(func() { close(done) }) },
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.AddNamed(`b`, stoppedTicker(errors.New(`other error`))); err != nil {
+		t.Fatal(err)
+	}
+	if !m.Remove(`a`) {
+		t.Fatal(`expected remove`)
+	}
+	<-m.Done()
+	if err := m.Err(); err == nil || err.Error() != `other error` {
+		t.Error(err)
+	}
+	if info, ok := m.Get(`b`); !ok || info.Running || info.Err == nil || info.Err.Error() != `other error` {
+		t.Error(info, ok)
+	}
+}
+
+// mockManager implements only the methods of Manager, i.e. it doesn't implement NamedManager
+type mockManager struct {
+	mockTicker
+	add func(ticker Ticker) error
+}
+
+func (m mockManager) Add(ticker Ticker) error { return m.add(ticker) }
+
+func TestManager_externalImplementation(t *testing.T) {
+	var m any = mockManager{}
+	if _, ok := m.(Manager); !ok {
+		t.Error(`expected Manager`)
+	}
+	if _, ok := m.(NamedManager); ok {
+		t.Error(`unexpected NamedManager`)
+	}
+	var named Manager = NewManager()
+	if _, ok := named.(NamedManager); !ok {
+		t.Error(`expected NamedManager`)
+	}
+}
+
 type mockTicker struct {
 	done func() <-chan struct{}
 	err  func() error
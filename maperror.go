@@ -0,0 +1,170 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+type (
+	// NodeError is an error annotated with the location of the node that returned it, see AnnotateErrors
+	NodeError struct {
+		// Nodes are the (original) nodes, from the root, to the node that returned Err
+		Nodes []Node
+		// Path is the index of each node (excluding the root) within the children of it's parent, e.g. [1 0] is the
+		// first child of the second child of the root
+		Path []int
+		// Err is the original error
+		Err error
+	}
+)
+
+// MapError wraps a tick such that any error will be mapped using fn, the result of which will be returned, e.g. to
+// swallow (by returning a nil error), or wrap, errors. Ticks that don't return an error are unaffected, see also
+// MapStatus. Nil will be returned if tick is nil, and a panic will occur if fn is nil.
+func MapError(tick Tick, fn func(err error) (Status, error)) Tick {
+	if fn == nil {
+		panic(errors.New(`behaviortree.MapError nil fn`))
+	}
+	if tick == nil {
+		return nil
+	}
+	return decorate(tick, func(children []Node) (Status, error) {
+		status, err := tick(children)
+		if err != nil {
+			return fn(err)
+		}
+		return status, nil
+	})
+}
+
+// ErrorAsFailure wraps a tick such that any error matching E (see errors.As) will be converted into failure, without
+// an error, e.g. ErrorAsFailure[*os.PathError](tick), or ErrorAsFailure[error](tick) to convert all errors. Nil will
+// be returned if tick is nil.
+func ErrorAsFailure[E error](tick Tick) Tick {
+	if tick == nil {
+		return nil
+	}
+	return MapError(tick, func(err error) (Status, error) {
+		var target E
+		if errors.As(err, &target) {
+			return Failure, nil
+		}
+		return Failure, err
+	})
+}
+
+// FailureAsError wraps a tick such that any failure, without an error, will return err, the reverse of
+// ErrorAsFailure, e.g. to stop a Ticker with a sentinel error. Nil will be returned if tick is nil, and a panic will
+// occur if err is nil.
+func FailureAsError(tick Tick, err error) Tick {
+	if err == nil {
+		panic(errors.New(`behaviortree.FailureAsError nil err`))
+	}
+	if tick == nil {
+		return nil
+	}
+	return decorate(tick, func(children []Node) (Status, error) {
+		status, e := tick(children)
+		if e == nil && status == Failure {
+			return Failure, err
+		}
+		return status, e
+	})
+}
+
+// AnnotateErrors returns node wrapped such that any error returned by the tick of it or any descendant will be
+// annotated with the location of the node that returned it, by wrapping it in a *NodeError, e.g. to identify which
+// leaf an error returned from a Ticker originated from. Errors that already wrap a *NodeError are returned as-is,
+// meaning the deepest node is reported. Nodes are wrapped recursively, on expansion, in the same manner as Trace, and
+// attached values are preserved. Nil will be returned if node is nil.
+func AnnotateErrors(node Node) Node {
+	if node == nil {
+		return nil
+	}
+	return wrapNode(node, func(x *wrappedNode, tick Tick) Tick {
+		return func(children []Node) (Status, error) {
+			status, err := tick(children)
+			if err != nil {
+				var target *NodeError
+				if !errors.As(err, &target) {
+					err = annotateError(x, err)
+				}
+			}
+			return status, err
+		}
+	})
+}
+
+// Error implements the error interface, identifying the node, from the root, by the index and name (see GetName) of
+// each node, e.g. "node root/1:guard/0: some error"
+func (e *NodeError) Error() string {
+	var b strings.Builder
+	b.WriteString(`node `)
+	for i, node := range e.Nodes {
+		var name string
+		if node != nil {
+			name = GetName(node)
+		}
+		if i == 0 {
+			if name == `` {
+				name = `root`
+			}
+			b.WriteString(name)
+			continue
+		}
+		b.WriteByte('/')
+		if i-1 < len(e.Path) {
+			b.WriteString(strconv.Itoa(e.Path[i-1]))
+		}
+		if name != `` {
+			b.WriteByte(':')
+			b.WriteString(name)
+		}
+	}
+	b.WriteString(`: `)
+	if e.Err != nil {
+		b.WriteString(e.Err.Error())
+	}
+	return b.String()
+}
+
+// Unwrap returns the original error
+func (e *NodeError) Unwrap() error { return e.Err }
+
+// Node returns the node that returned the error, i.e. the last of Nodes, or nil
+func (e *NodeError) Node() Node {
+	if len(e.Nodes) == 0 {
+		return nil
+	}
+	return e.Nodes[len(e.Nodes)-1]
+}
+
+// annotateError builds a NodeError for err, returned by x
+func annotateError(x *wrappedNode, err error) *NodeError {
+	e := NodeError{
+		Nodes: make([]Node, len(x.path)+1),
+		Path:  append([]int(nil), x.path...),
+		Err:   err,
+	}
+	for i := len(x.path); x != nil; i, x = i-1, x.parent {
+		e.Nodes[i] = x.node
+	}
+	return &e
+}
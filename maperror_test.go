@@ -0,0 +1,172 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"reflect"
+	"testing"
+)
+
+func TestMapError(t *testing.T) {
+	e := errors.New(`some_error`)
+	var calls int
+	tick := MapError(resultTick(Running, e), func(err error) (Status, error) {
+		calls++
+		return Success, fmt.Errorf(`wrapped: %w`, err)
+	})
+	if status, err := tick(nil); !errors.Is(err, e) || err.Error() != `wrapped: some_error` || status != Success {
+		t.Error(status, err)
+	}
+	tick = MapError(resultTick(Running, nil), func(err error) (Status, error) {
+		calls++
+		return Failure, nil
+	})
+	if status, err := tick(nil); err != nil || status != Running {
+		t.Error(status, err)
+	}
+	if calls != 1 {
+		t.Error(calls)
+	}
+	if MapError(nil, func(err error) (Status, error) { return Failure, nil }) != nil {
+		t.Error(`expected nil`)
+	}
+}
+
+func TestMapError_nilFn(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil || r.(error).Error() != `behaviortree.MapError nil fn` {
+			t.Error(r)
+		}
+	}()
+	MapError(resultTick(Success, nil), nil)
+}
+
+func TestErrorAsFailure(t *testing.T) {
+	var (
+		e    = errors.New(`some_error`)
+		perr = fmt.Errorf(`wrapped: %w`, &fs.PathError{Op: `open`, Path: `x`, Err: fs.ErrNotExist})
+	)
+	if status, err := ErrorAsFailure[*fs.PathError](resultTick(Success, perr))(nil); err != nil || status != Failure {
+		t.Error(status, err)
+	}
+	if status, err := ErrorAsFailure[*fs.PathError](resultTick(Success, e))(nil); err != e || status != Failure {
+		t.Error(status, err)
+	}
+	if status, err := ErrorAsFailure[*fs.PathError](resultTick(Running, nil))(nil); err != nil || status != Running {
+		t.Error(status, err)
+	}
+	if status, err := ErrorAsFailure[error](resultTick(Success, e))(nil); err != nil || status != Failure {
+		t.Error(status, err)
+	}
+	if ErrorAsFailure[error](nil) != nil {
+		t.Error(`expected nil`)
+	}
+}
+
+func TestFailureAsError(t *testing.T) {
+	var (
+		e     = errors.New(`some_error`)
+		other = errors.New(`other_error`)
+	)
+	if status, err := FailureAsError(resultTick(Failure, nil), e)(nil); err != e || status != Failure {
+		t.Error(status, err)
+	}
+	if status, err := FailureAsError(resultTick(Failure, other), e)(nil); err != other || status != Failure {
+		t.Error(status, err)
+	}
+	if status, err := FailureAsError(resultTick(Success, nil), e)(nil); err != nil || status != Success {
+		t.Error(status, err)
+	}
+	if FailureAsError(nil, e) != nil {
+		t.Error(`expected nil`)
+	}
+	defer func() {
+		if r := recover(); r == nil || r.(error).Error() != `behaviortree.FailureAsError nil err` {
+			t.Error(r)
+		}
+	}()
+	FailureAsError(resultTick(Success, nil), nil)
+}
+
+func TestAnnotateErrors(t *testing.T) {
+	var (
+		e    = errors.New(`some_error`)
+		leaf = New(resultTick(Failure, e)).WithName(`leaf`)
+		mid  = New(Sequence, New(resultTick(Success, nil)), leaf)
+		root = New(Selector, New(resultTick(Failure, nil)).WithName(`check`), mid).WithName(`tree`)
+		node = AnnotateErrors(root)
+	)
+	status, err := node.Tick()
+	if status != Failure || !errors.Is(err, e) {
+		t.Fatal(status, err)
+	}
+	var target *NodeError
+	if !errors.As(err, &target) {
+		t.Fatal(err)
+	}
+	if v := err.Error(); v != `node tree/1/1:leaf: some_error` {
+		t.Error(v)
+	}
+	if !reflect.DeepEqual(target.Path, []int{1, 1}) {
+		t.Error(target.Path)
+	}
+	if len(target.Nodes) != 3 || GetName(target.Node()) != `leaf` || GetName(target.Nodes[0]) != `tree` {
+		t.Error(target.Nodes)
+	}
+	// values are preserved
+	if name := GetName(node); name != `tree` {
+		t.Error(name)
+	}
+	if AnnotateErrors(nil) != nil {
+		t.Error(`expected nil`)
+	}
+}
+
+func TestAnnotateErrors_composite(t *testing.T) {
+	// errors returned by a composite, e.g. ticking a nil child, are attributed to the composite
+	node := AnnotateErrors(New(Sequence, New(Sequence, nil)))
+	_, err := node.Tick()
+	var target *NodeError
+	if !errors.As(err, &target) {
+		t.Fatal(err)
+	}
+	if v := err.Error(); v != `node root/0: behaviortree.Node cannot tick a nil node` {
+		t.Error(v)
+	}
+	if v := (&NodeError{}).Error(); v != `node : ` {
+		t.Error(v)
+	}
+	if (&NodeError{}).Node() != nil {
+		t.Error(`expected nil`)
+	}
+}
+
+func TestAnnotateErrors_halt(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`a`: Running, `b`: Success}}
+	node := AnnotateErrors(New(SequenceWithMemory(), r.node(`a`), r.node(`b`)))
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	r.take()
+	node.Halt()
+	if v := r.take(); !reflect.DeepEqual(v, []string{`halt a`}) {
+		t.Error(v)
+	}
+}
@@ -0,0 +1,68 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import "errors"
+
+// ForceSuccess wraps a tick such that any non-running status will be success, note that any error will still be
+// returned as-is, see also ErrorAsFailure. Nil will be returned if tick is nil.
+func ForceSuccess(tick Tick) Tick {
+	if tick == nil {
+		return nil
+	}
+	return decorate(tick, func(children []Node) (Status, error) {
+		status, err := tick(children)
+		if err != nil || status == Running {
+			return status, err
+		}
+		return Success, nil
+	})
+}
+
+// ForceFailure wraps a tick such that any non-running status will be failure, note that any error will still be
+// returned as-is, see also ErrorAsFailure. Nil will be returned if tick is nil.
+func ForceFailure(tick Tick) Tick {
+	if tick == nil {
+		return nil
+	}
+	return decorate(tick, func(children []Node) (Status, error) {
+		status, err := tick(children)
+		if err != nil || status == Running {
+			return status, err
+		}
+		return Failure, nil
+	})
+}
+
+// MapStatus wraps a tick such that any status returned without an error will be mapped using fn, e.g. to implement
+// arbitrary status transitions. Any error will be returned as-is, see MapError. Nil will be returned if tick is nil,
+// and a panic will occur if fn is nil.
+func MapStatus(tick Tick, fn func(status Status) Status) Tick {
+	if fn == nil {
+		panic(errors.New(`behaviortree.MapStatus nil fn`))
+	}
+	if tick == nil {
+		return nil
+	}
+	return decorate(tick, func(children []Node) (Status, error) {
+		status, err := tick(children)
+		if err != nil {
+			return status, err
+		}
+		return fn(status), nil
+	})
+}
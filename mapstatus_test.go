@@ -0,0 +1,101 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"errors"
+	"testing"
+)
+
+// resultTick returns a tick that always returns the given result
+func resultTick(status Status, err error) Tick {
+	return func(children []Node) (Status, error) { return status, err }
+}
+
+func TestForceSuccess(t *testing.T) {
+	e := errors.New(`some_error`)
+	for _, tc := range []struct {
+		Status   Status
+		Err      error
+		Expected Status
+	}{
+		{Success, nil, Success},
+		{Failure, nil, Success},
+		{Running, nil, Running},
+		{Status(99), nil, Success},
+		{Failure, e, Failure},
+	} {
+		if status, err := ForceSuccess(resultTick(tc.Status, tc.Err))(nil); err != tc.Err || status != tc.Expected {
+			t.Error(tc.Status, tc.Err, status, err)
+		}
+	}
+	if ForceSuccess(nil) != nil {
+		t.Error(`expected nil`)
+	}
+}
+
+func TestForceFailure(t *testing.T) {
+	e := errors.New(`some_error`)
+	for _, tc := range []struct {
+		Status   Status
+		Err      error
+		Expected Status
+	}{
+		{Success, nil, Failure},
+		{Failure, nil, Failure},
+		{Running, nil, Running},
+		{Success, e, Success},
+	} {
+		if status, err := ForceFailure(resultTick(tc.Status, tc.Err))(nil); err != tc.Err || status != tc.Expected {
+			t.Error(tc.Status, tc.Err, status, err)
+		}
+	}
+	if ForceFailure(nil) != nil {
+		t.Error(`expected nil`)
+	}
+}
+
+func TestMapStatus(t *testing.T) {
+	fn := func(status Status) Status {
+		if status == Running {
+			return Failure
+		}
+		return status
+	}
+	if status, err := MapStatus(resultTick(Running, nil), fn)(nil); err != nil || status != Failure {
+		t.Error(status, err)
+	}
+	if status, err := MapStatus(resultTick(Success, nil), fn)(nil); err != nil || status != Success {
+		t.Error(status, err)
+	}
+	e := errors.New(`some_error`)
+	if status, err := MapStatus(resultTick(Running, e), fn)(nil); err != e || status != Running {
+		t.Error(status, err)
+	}
+	if MapStatus(nil, fn) != nil {
+		t.Error(`expected nil`)
+	}
+}
+
+func TestMapStatus_nilFn(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil || r.(error).Error() != `behaviortree.MapStatus nil fn` {
+			t.Error(r)
+		}
+	}()
+	MapStatus(resultTick(Success, nil), nil)
+}
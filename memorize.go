@@ -16,6 +16,8 @@
 
 package behaviortree
 
+import "sync"
+
 // Memorize encapsulates a tick, and will cache the first non-running status for each child, per "execution", defined
 // as the period until the first non-running status, of the encapsulated tick, facilitating execution of asynchronous
 // nodes in serial with their siblings, using stateless tick implementations, such as sequence and selector.
@@ -23,17 +25,42 @@ package behaviortree
 // Sync provides a similar but more flexible mechanism, at the expense of greater complexity, and more cumbersome
 // usage. Sync supports modification of children mid-execution, and may be used to implement complex guarding behavior
 // as children of a single Tick, equivalent to more complex structures using multiple memorized sequence nodes.
+//
+// Any children that last returned running, at the end of each execution, will be halted (see Node.Halt). Halting the
+// node will end the current execution, halting any children that were running, such that the next tick will start a
+// new execution, re-ticking all children.
 func Memorize(tick Tick) Tick {
 	if tick == nil {
 		return nil
 	}
 	var (
 		started bool
+		src     []Node
 		nodes   []Node
+		mutex   sync.Mutex
+		running []bool
 	)
-	return func(children []Node) (status Status, err error) {
+	// reset ends the execution, returning the (source) children that were running
+	reset := func() (halt []Node) {
+		mutex.Lock()
+		for i, node := range src {
+			if running[i] {
+				halt = append(halt, node)
+			}
+		}
+		mutex.Unlock()
+		started = false
+		src = nil
+		nodes = nil
+		running = nil
+		return
+	}
+	return Tick(func(children []Node) (status Status, err error) {
 		if !started {
+			src = copyNodes(children)
 			nodes = copyNodes(children)
+			running = make([]bool, len(nodes))
+			running := running // per execution, in case any wrapped children outlive it
 			for i := range nodes {
 				var (
 					child    = nodes[i]
@@ -50,22 +77,32 @@ func Memorize(tick Tick) Tick {
 					if tick == nil {
 						return nil, nodes
 					}
-					return func(children []Node) (Status, error) {
+					return decorate(tick, func(children []Node) (Status, error) {
 						status, err := tick(children)
+						mutex.Lock()
+						running[i] = err == nil && status == Running
+						mutex.Unlock()
 						if err != nil || status != Running {
-							override = func(children []Node) (Status, error) { return status, err }
+							override = decorate(tick, func(children []Node) (Status, error) { return status, err })
 						}
 						return status, err
-					}, nodes
+					}), nodes
 				}
 			}
 			started = true
 		}
 		status, err = tick(nodes)
 		if err != nil || status != Running {
-			started = false
-			nodes = nil
+			haltNodes(reset())
 		}
 		return
-	}
+	}).WithHalt(func(children []Node) {
+		halt := reset()
+		if haltableOf(tick) != nil {
+			// the wrapped tick is responsible for halting its own children
+			tick.Halt(children)
+			return
+		}
+		haltNodes(halt)
+	})
 }
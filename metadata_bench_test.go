@@ -164,3 +164,45 @@ func BenchmarkWalk_HybridOptimized(b *testing.B) {
 		b.Fatalf("expected %d nodes, got %d", expected, count)
 	}
 }
+
+// buildCustomDeep constructs a linear chain of custom nodes, which must be expanded to resolve values
+func buildCustomDeep(n int) Node {
+	current := noOpNode
+	for i := 0; i < n; i++ {
+		child := current
+		current = func() (Tick, []Node) {
+			return func(children []Node) (Status, error) { return Success, nil }, []Node{child}
+		}
+	}
+	return current
+}
+
+// benchmarkWalkParallel walks independent trees (built per goroutine) concurrently
+func benchmarkWalkParallel(b *testing.B, build func() Node, expected int) {
+	b.RunParallel(func(pb *testing.PB) {
+		root := build()
+		for pb.Next() {
+			count := 0
+			Walk(root, func(n Metadata) bool {
+				count++
+				return true
+			})
+			if count != expected {
+				b.Errorf("expected %d nodes, got %d", expected, count)
+				return
+			}
+		}
+	})
+}
+
+func BenchmarkWalk_Deep100_Parallel(b *testing.B) {
+	benchmarkWalkParallel(b, func() Node { return buildDeep(100) }, 101)
+}
+
+func BenchmarkWalk_StructureDeep100_Parallel(b *testing.B) {
+	benchmarkWalkParallel(b, func() Node { return buildStructureDeep(100) }, 101)
+}
+
+func BenchmarkWalk_CustomDeep100_Parallel(b *testing.B) {
+	benchmarkWalkParallel(b, func() Node { return buildCustomDeep(100) }, 101)
+}
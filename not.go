@@ -22,7 +22,7 @@ func Not(tick Tick) Tick {
 	if tick == nil {
 		return nil
 	}
-	return func(children []Node) (Status, error) {
+	return decorate(tick, func(children []Node) (Status, error) {
 		status, err := tick(children)
 		if err != nil {
 			return Failure, err
@@ -35,5 +35,5 @@ func Not(tick Tick) Tick {
 		default:
 			return Failure, nil
 		}
-	}
+	})
 }
@@ -0,0 +1,126 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+const (
+	// SuccessOnOne is a success threshold for Parallel, which will succeed after the first child succeeds
+	SuccessOnOne = 1
+	// SuccessOnAll is a success threshold for Parallel, which will succeed only if all children succeed
+	SuccessOnAll = -1
+	// FailOnOne is a failure threshold for Parallel, which will fail after the first child fails
+	FailOnOne = 1
+	// FailOnAll is a failure threshold for Parallel, which will fail only if all children fail
+	FailOnAll = -1
+)
+
+// Parallel generates a stateful Tick which will tick all children (sequentially, within the same tick), until the
+// number of successful children reaches the success threshold (returning success), the number of failed children
+// reaches the failure threshold, or the success threshold becomes unreachable (returning failure), or the first error
+// (returning the error), otherwise returning running, and ticking only those which returned running in subsequent
+// calls, repeating this cycle for subsequent ticks. Any children which returned running (in the current cycle) will
+// be halted, if the cycle ends early, or the node is halted (see Node.Halt), the latter also resetting the cycle.
+//
+// Negative thresholds are relative to the number of children, e.g. -1 (SuccessOnAll, FailOnAll) is all children, and
+// -2 is one less than all children, while thresholds exceeding the number of children are treated as all children.
+//
+// See also Fork, which ticks all children concurrently, and requires all to succeed.
+func Parallel(successThreshold, failureThreshold int) Tick {
+	var (
+		statuses []Status
+		// running indicates the children that returned running, the last time they were ticked
+		running []bool
+	)
+	// reset ends the cycle, returning the children that were running
+	reset := func(children []Node) (halt []Node) {
+		for i, ok := range running {
+			if ok && i < len(children) {
+				halt = append(halt, children[i])
+			}
+		}
+		statuses = nil
+		running = nil
+		return
+	}
+	return Tick(func(children []Node) (Status, error) {
+		if statuses == nil || len(statuses) != len(children) {
+			// cycle start
+			statuses = make([]Status, len(children))
+			running = make([]bool, len(children))
+		}
+		var (
+			successes, failures int
+			total               = len(statuses)
+			success             = parallelThreshold(successThreshold, total)
+			failure             = parallelThreshold(failureThreshold, total)
+		)
+		end := func(status Status, err error) (Status, error) {
+			haltNodes(reset(children))
+			return status, err
+		}
+		for _, status := range statuses {
+			switch status {
+			case Success:
+				successes++
+			case Failure:
+				failures++
+			}
+		}
+		for i := 0; ; i++ {
+			if successes >= success {
+				return end(Success, nil)
+			}
+			if failures >= failure || failures > total-success {
+				return end(Failure, nil)
+			}
+			if i == total {
+				return Running, nil
+			}
+			if statuses[i] != 0 {
+				continue
+			}
+			status, err := children[i].Tick()
+			running[i] = err == nil && status == Running
+			if err != nil {
+				statuses[i] = Failure
+				return end(Failure, err)
+			}
+			switch status {
+			case Running:
+			case Success:
+				statuses[i] = Success
+				successes++
+			default:
+				statuses[i] = Failure
+				failures++
+			}
+		}
+	}).WithHalt(func(children []Node) { haltNodes(reset(children)) })
+}
+
+// parallelThreshold resolves a threshold (see Parallel), for the given number of children
+func parallelThreshold(threshold, total int) int {
+	if threshold < 0 {
+		threshold += total + 1
+		if threshold < 0 {
+			threshold = 0
+		}
+	}
+	if threshold > total {
+		threshold = total
+	}
+	return threshold
+}
@@ -0,0 +1,167 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParallel(t *testing.T) {
+	type step struct {
+		Update   map[string]Status
+		Expected Status
+		Events   []string
+	}
+	for _, tc := range []struct {
+		Name    string
+		Success int
+		Failure int
+		Steps   []step
+	}{
+		{
+			Name:    `success on all`,
+			Success: SuccessOnAll,
+			Failure: FailOnOne,
+			Steps: []step{
+				{map[string]Status{`a`: Success, `b`: Running, `c`: Running}, Running, []string{`tick a`, `tick b`, `tick c`}},
+				{map[string]Status{`b`: Success}, Running, []string{`tick b`, `tick c`}},
+				{map[string]Status{`c`: Success}, Success, []string{`tick c`}},
+				{map[string]Status{`a`: Running}, Running, []string{`tick a`, `tick b`, `tick c`}},
+			},
+		},
+		{
+			Name:    `fail on one`,
+			Success: SuccessOnAll,
+			Failure: FailOnOne,
+			Steps: []step{
+				{map[string]Status{`a`: Running, `b`: Failure, `c`: Running}, Failure, []string{`tick a`, `tick b`, `halt a`}},
+			},
+		},
+		{
+			Name:    `success on one`,
+			Success: SuccessOnOne,
+			Failure: FailOnAll,
+			Steps: []step{
+				{map[string]Status{`a`: Failure, `b`: Running, `c`: Running}, Running, []string{`tick a`, `tick b`, `tick c`}},
+				{map[string]Status{`b`: Running, `c`: Success}, Success, []string{`tick b`, `tick c`, `halt b`}},
+			},
+		},
+		{
+			Name:    `fail on all`,
+			Success: SuccessOnOne,
+			Failure: FailOnAll,
+			Steps: []step{
+				{map[string]Status{`a`: Failure, `b`: Failure, `c`: Running}, Running, []string{`tick a`, `tick b`, `tick c`}},
+				{map[string]Status{`c`: Failure}, Failure, []string{`tick c`}},
+			},
+		},
+		{
+			Name:    `success unreachable`,
+			Success: 2,
+			Failure: FailOnAll,
+			Steps: []step{
+				{map[string]Status{`a`: Failure, `b`: Failure, `c`: Running}, Failure, []string{`tick a`, `tick b`}},
+			},
+		},
+		{
+			Name:    `relative threshold`,
+			Success: -2,
+			Failure: 10,
+			Steps: []step{
+				{map[string]Status{`a`: Success, `b`: Running, `c`: Success}, Success, []string{`tick a`, `tick b`, `tick c`, `halt b`}},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			r := &haltRecorder{statuses: make(map[string]Status)}
+			node := New(Parallel(tc.Success, tc.Failure), r.node(`a`), r.node(`b`), r.node(`c`))
+			for i, s := range tc.Steps {
+				for k, v := range s.Update {
+					r.statuses[k] = v
+				}
+				if status, err := node.Tick(); err != nil || status != s.Expected {
+					t.Fatal(i, status, err)
+				}
+				if v := r.take(); !reflect.DeepEqual(v, s.Events) {
+					t.Fatal(i, v)
+				}
+			}
+		})
+	}
+}
+
+func TestParallel_error(t *testing.T) {
+	e := errors.New(`some_error`)
+	r := &haltRecorder{statuses: map[string]Status{`a`: Running, `b`: Running}, errs: map[string]error{`b`: e}}
+	node := New(Parallel(SuccessOnAll, FailOnAll), r.node(`a`), r.node(`b`), r.node(`c`))
+	if status, err := node.Tick(); err != e || status != Failure {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `tick b`, `halt a`}) {
+		t.Error(v)
+	}
+}
+
+func TestParallel_halt(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`a`: Success, `b`: Running, `c`: Running}}
+	node := New(Parallel(SuccessOnAll, FailOnOne), r.node(`a`), r.node(`b`), r.node(`c`))
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	r.take()
+	node.Halt()
+	if v := r.take(); !reflect.DeepEqual(v, []string{`halt b`, `halt c`}) {
+		t.Error(v)
+	}
+	node.Halt()
+	if v := r.take(); v != nil {
+		t.Error(v)
+	}
+	// a new cycle, so a is ticked again
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `tick b`, `tick c`}) {
+		t.Error(v)
+	}
+}
+
+func TestParallel_noChildren(t *testing.T) {
+	if status, err := Parallel(SuccessOnAll, FailOnOne)(nil); err != nil || status != Success {
+		t.Error(status, err)
+	}
+	if status, err := Parallel(SuccessOnOne, FailOnOne)(nil); err != nil || status != Success {
+		t.Error(status, err)
+	}
+}
+
+func TestParallel_childrenChanged(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`a`: Success, `b`: Running}}
+	tick := Parallel(SuccessOnAll, FailOnOne)
+	if status, err := tick([]Node{r.node(`a`), r.node(`b`)}); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	r.take()
+	if status, err := tick([]Node{r.node(`a`)}); err != nil || status != Success {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`}) {
+		t.Error(v)
+	}
+}
@@ -89,6 +89,9 @@ func DefaultPrinterInspector(node Node, tick Tick) ([]any, any) {
 	if name := node.Name(); name != "" {
 		nodeName = name
 	}
+	if attempts := GetRetryAttempts(node); attempts != 0 {
+		nodeName += " (attempt " + strconv.Itoa(attempts) + ")"
+	}
 
 	if v := tick.Frame(); v != nil {
 		tickStrings = getFrameStrings(v)
@@ -67,7 +67,7 @@ func TestNode_String(t *testing.T) {
 		{
 			Name:  `single sequence`,
 			Node:  New(Sequence),
-			Value: "[0x1 printer_test.go:69 0x2 sequence.go:21]  github.com/joeycumines/go-behaviortree.TestNode_String | github.com/joeycumines/go-behaviortree.Sequence",
+			Value: "[0x1 printer_test.go:69 0x2 sequence.go:22]  github.com/joeycumines/go-behaviortree.TestNode_String | github.com/joeycumines/go-behaviortree.Sequence",
 		},
 		{
 			Name:  `single closure`,
@@ -82,7 +82,7 @@ func TestNode_String(t *testing.T) {
 		{
 			Name:  `example counter`,
 			Node:  newExampleCounter(),
-			Value: "[0x1 example_test.go:47 0x2 selector.go:21    ]  github.com/joeycumines/go-behaviortree.newExampleCounter | github.com/joeycumines/go-behaviortree.Selector\n├── [0x3 example_test.go:49 0x4 sequence.go:21    ]  github.com/joeycumines/go-behaviortree.newExampleCounter | github.com/joeycumines/go-behaviortree.Sequence\n│   ├── [0x5 example_test.go:51 0x6 example_test.go:52]  github.com/joeycumines/go-behaviortree.newExampleCounter | github.com/joeycumines/go-behaviortree.newExampleCounter.funcN\n│   ├── [0x7 example_test.go:40 0x8 example_test.go:41]  github.com/joeycumines/go-behaviortree.newExampleCounter | github.com/joeycumines/go-behaviortree.newExampleCounter.funcN\n│   └── [0x9 example_test.go:32 0xa example_test.go:33]  github.com/joeycumines/go-behaviortree.newExampleCounter.funcN | github.com/joeycumines/go-behaviortree.newExampleCounter.newExampleCounter.funcN\n└── [0xb example_test.go:62 0x4 sequence.go:21    ]  github.com/joeycumines/go-behaviortree.newExampleCounter | github.com/joeycumines/go-behaviortree.Sequence\n    ├── [0xc example_test.go:64 0xd example_test.go:65]  github.com/joeycumines/go-behaviortree.newExampleCounter | github.com/joeycumines/go-behaviortree.newExampleCounter.funcN\n    ├── [0x7 example_test.go:40 0x8 example_test.go:41]  github.com/joeycumines/go-behaviortree.newExampleCounter | github.com/joeycumines/go-behaviortree.newExampleCounter.funcN\n    └── [0xe example_test.go:32 0xf example_test.go:33]  github.com/joeycumines/go-behaviortree.newExampleCounter.funcN | github.com/joeycumines/go-behaviortree.newExampleCounter.newExampleCounter.funcN",
+			Value: "[0x1 example_test.go:47 0x2 selector.go:22    ]  github.com/joeycumines/go-behaviortree.newExampleCounter | github.com/joeycumines/go-behaviortree.Selector\n├── [0x3 example_test.go:49 0x4 sequence.go:22    ]  github.com/joeycumines/go-behaviortree.newExampleCounter | github.com/joeycumines/go-behaviortree.Sequence\n│   ├── [0x5 example_test.go:51 0x6 example_test.go:52]  github.com/joeycumines/go-behaviortree.newExampleCounter | github.com/joeycumines/go-behaviortree.newExampleCounter.funcN\n│   ├── [0x7 example_test.go:40 0x8 example_test.go:41]  github.com/joeycumines/go-behaviortree.newExampleCounter | github.com/joeycumines/go-behaviortree.newExampleCounter.funcN\n│   └── [0x9 example_test.go:32 0xa example_test.go:33]  github.com/joeycumines/go-behaviortree.newExampleCounter.funcN | github.com/joeycumines/go-behaviortree.newExampleCounter.newExampleCounter.funcN\n└── [0xb example_test.go:62 0x4 sequence.go:22    ]  github.com/joeycumines/go-behaviortree.newExampleCounter | github.com/joeycumines/go-behaviortree.Sequence\n    ├── [0xc example_test.go:64 0xd example_test.go:65]  github.com/joeycumines/go-behaviortree.newExampleCounter | github.com/joeycumines/go-behaviortree.newExampleCounter.funcN\n    ├── [0x7 example_test.go:40 0x8 example_test.go:41]  github.com/joeycumines/go-behaviortree.newExampleCounter | github.com/joeycumines/go-behaviortree.newExampleCounter.funcN\n    └── [0xe example_test.go:32 0xf example_test.go:33]  github.com/joeycumines/go-behaviortree.newExampleCounter.funcN | github.com/joeycumines/go-behaviortree.newExampleCounter.newExampleCounter.funcN",
 		},
 	} {
 		t.Run(testCase.Name, func(t *testing.T) {
@@ -187,7 +187,7 @@ func TestDefaultPrinterInspector_nil(t *testing.T) {
 				`0x0`,
 				`-`,
 				fmt.Sprintf(`%p`, tick),
-				`selector.go:21`,
+				`selector.go:22`,
 			},
 			`<nil> | github.com/joeycumines/go-behaviortree.Selector`,
 		},
@@ -197,7 +197,7 @@ func TestDefaultPrinterInspector_nil(t *testing.T) {
 				`0x0`,
 				`-`,
 				fmt.Sprintf(`%p`, tick),
-				`selector.go:21`,
+				`selector.go:22`,
 			},
 			`<nil> | github.com/joeycumines/go-behaviortree.Selector`,
 		}, actual)
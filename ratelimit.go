@@ -18,11 +18,27 @@ package behaviortree
 
 import "time"
 
+type (
+	// RateLimitOption configures the behavior of RateLimit, see also WithClock
+	RateLimitOption interface {
+		applyRateLimit(c *rateLimitConfig)
+	}
+
+	rateLimitConfig struct {
+		clock Clock
+	}
+)
+
 // RateLimit generates a stateful Tick that will return success at most once per a given duration
-func RateLimit(d time.Duration) Tick {
+func RateLimit(d time.Duration, options ...RateLimitOption) Tick {
+	var c rateLimitConfig
+	for _, o := range options {
+		o.applyRateLimit(&c)
+	}
+	clock := orDefaultClock(c.clock)
 	var last *time.Time
 	return func(children []Node) (Status, error) {
-		now := time.Now()
+		now := clock.Now()
 		if last != nil && now.Add(-d).Before(*last) {
 			return Failure, nil
 		}
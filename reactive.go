@@ -0,0 +1,111 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+// ReactiveSequence generates a stateful Tick implementing the reactive sequence (the sequence without memory, as
+// defined by Colledanchise & Ögren), which ticks each child from the first, every tick, like Sequence, until the first
+// error (returning the error), the first non-success status (returning the status, or failure), or all children are
+// ticked (returning success). Any child that was running, on the previous tick, but was skipped, e.g. as an earlier
+// child (a condition) stopped succeeding, will be halted (see Node.Halt).
+//
+// This implementation is equivalent to HaltPreempted(Sequence), see also SequenceWithMemory.
+func ReactiveSequence() Tick {
+	p := &preemption{tick: Sequence}
+	return Tick(func(children []Node) (Status, error) { return p.run(children) }).WithHalt(p.halt)
+}
+
+// ReactiveSelector generates a stateful Tick implementing the reactive fallback (the fallback without memory, as
+// defined by Colledanchise & Ögren), which ticks each child from the first, every tick, like Selector, until the first
+// error (returning the error), the first non-failure status (returning the status), or all children are ticked
+// (returning failure). Any child that was running, on the previous tick, but was skipped, e.g. as an earlier (higher
+// priority) child stopped failing, will be halted (see Node.Halt).
+//
+// This implementation is equivalent to HaltPreempted(Selector), see also SelectorWithMemory.
+func ReactiveSelector() Tick {
+	p := &preemption{tick: Selector}
+	return Tick(func(children []Node) (Status, error) { return p.run(children) }).WithHalt(p.halt)
+}
+
+// SequenceWithMemory generates a stateful Tick implementing the sequence with memory (as defined by Colledanchise &
+// Ögren), which behaves like ReactiveSequence, except that, after a child returns running, subsequent ticks will
+// resume from that child, without re-ticking any (successful) children prior to it, until the sequence completes,
+// i.e. returns a non-running status, or an error.
+//
+// The state is keyed on the index of the running child, and will be reset if the number of children shrinks below it.
+// Halting the node (see Node.Halt), i.e. preemption by its parent, will halt the running child (if any), and reset
+// the state, such that the next tick will start from the first child.
+func SequenceWithMemory() Tick {
+	m := &memory{sequence: true}
+	return Tick(func(children []Node) (Status, error) { return m.run(children) }).WithHalt(m.halt)
+}
+
+// SelectorWithMemory generates a stateful Tick implementing the fallback with memory (as defined by Colledanchise &
+// Ögren), which behaves like ReactiveSelector, except that, after a child returns running, subsequent ticks will
+// resume from that child, without re-ticking any (failed) children prior to it, until the selector completes, i.e.
+// returns a non-running status, or an error.
+//
+// The same caveats as SequenceWithMemory apply.
+func SelectorWithMemory() Tick {
+	m := &memory{sequence: false}
+	return Tick(func(children []Node) (Status, error) { return m.run(children) }).WithHalt(m.halt)
+}
+
+// memory implements SequenceWithMemory (if sequence is true) and SelectorWithMemory
+type memory struct {
+	sequence bool
+	// index is the child to resume from, which was running, if running is true
+	index   int
+	running bool
+}
+
+func (m *memory) run(children []Node) (Status, error) {
+	if m.index >= len(children) {
+		m.index, m.running = 0, false
+	}
+	for i := m.index; i < len(children); i++ {
+		status, err := children[i].Tick()
+		if err != nil {
+			m.index, m.running = 0, false
+			return Failure, err
+		}
+		if status == Running {
+			m.index, m.running = i, true
+			return Running, nil
+		}
+		if (status == Success) != m.sequence {
+			m.index, m.running = 0, false
+			if status == Success {
+				return Success, nil
+			}
+			return Failure, nil
+		}
+	}
+	m.index, m.running = 0, false
+	if m.sequence {
+		return Success, nil
+	}
+	return Failure, nil
+}
+
+// halt halts the running child, if any, resetting the state
+func (m *memory) halt(children []Node) {
+	index, running := m.index, m.running
+	m.index, m.running = 0, false
+	if running && index < len(children) {
+		children[index].Halt()
+	}
+}
@@ -0,0 +1,253 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestReactiveSequence_haltsRunning(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`cond`: Success, `action`: Running, `next`: Success}}
+	node := New(ReactiveSequence(), r.node(`cond`), r.node(`action`), r.node(`next`))
+	for i := 0; i < 2; i++ {
+		if status, err := node.Tick(); err != nil || status != Running {
+			t.Fatal(status, err)
+		}
+		if v := r.take(); !reflect.DeepEqual(v, []string{`tick cond`, `tick action`}) {
+			t.Error(i, v)
+		}
+	}
+	r.statuses[`cond`] = Failure
+	if status, err := node.Tick(); err != nil || status != Failure {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick cond`, `halt action`}) {
+		t.Error(v)
+	}
+	if kind := ReactiveSequence().Kind(); kind != `ReactiveSequence` {
+		t.Error(kind)
+	}
+}
+
+func TestReactiveSelector_haltsRunning(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`high`: Failure, `low`: Running, `last`: Success}}
+	node := New(ReactiveSelector(), r.node(`high`), r.node(`low`), r.node(`last`))
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick high`, `tick low`}) {
+		t.Error(v)
+	}
+	r.statuses[`high`] = Success
+	if status, err := node.Tick(); err != nil || status != Success {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick high`, `halt low`}) {
+		t.Error(v)
+	}
+	r.statuses[`high`] = Failure
+	r.statuses[`low`] = Failure
+	r.statuses[`last`] = Failure
+	if status, err := node.Tick(); err != nil || status != Failure {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick high`, `tick low`, `tick last`}) {
+		t.Error(v)
+	}
+	if kind := ReactiveSelector().Kind(); kind != `ReactiveSelector` {
+		t.Error(kind)
+	}
+}
+
+func TestSequenceWithMemory(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`a`: Success, `b`: Running, `c`: Success}}
+	node := New(SequenceWithMemory(), r.node(`a`), r.node(`b`), r.node(`c`))
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `tick b`}) {
+		t.Error(v)
+	}
+	// a isn't re-ticked, even though it would now fail
+	r.statuses[`a`] = Failure
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick b`}) {
+		t.Error(v)
+	}
+	r.statuses[`b`] = Success
+	if status, err := node.Tick(); err != nil || status != Success {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick b`, `tick c`}) {
+		t.Error(v)
+	}
+	// the next execution starts from the first child
+	if status, err := node.Tick(); err != nil || status != Failure {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`}) {
+		t.Error(v)
+	}
+}
+
+func TestSequenceWithMemory_error(t *testing.T) {
+	e := errors.New(`some_error`)
+	r := &haltRecorder{statuses: map[string]Status{`a`: Success, `b`: Success, `c`: Running}, errs: map[string]error{}}
+	node := New(SequenceWithMemory(), r.node(`a`), r.node(`b`), r.node(`c`))
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	r.take()
+	r.errs[`c`] = e
+	if status, err := node.Tick(); err != e || status != Failure {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick c`}) {
+		t.Error(v)
+	}
+	delete(r.errs, `c`)
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `tick b`, `tick c`}) {
+		t.Error(v)
+	}
+}
+
+func TestSequenceWithMemory_childrenShrink(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`a`: Success, `b`: Success, `c`: Running}}
+	children := []Node{r.node(`a`), r.node(`b`), r.node(`c`)}
+	tick := SequenceWithMemory()
+	node := Node(func() (Tick, []Node) { return tick, children })
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	r.take()
+	children = children[:2]
+	if status, err := node.Tick(); err != nil || status != Success {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `tick b`}) {
+		t.Error(v)
+	}
+	if status, err := SequenceWithMemory()(nil); err != nil || status != Success {
+		t.Error(status, err)
+	}
+}
+
+func TestSelectorWithMemory(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`a`: Failure, `b`: Running, `c`: Success}}
+	node := New(SelectorWithMemory(), r.node(`a`), r.node(`b`), r.node(`c`))
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `tick b`}) {
+		t.Error(v)
+	}
+	// a isn't re-ticked, even though it would now succeed
+	r.statuses[`a`] = Success
+	r.statuses[`b`] = Failure
+	if status, err := node.Tick(); err != nil || status != Success {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick b`, `tick c`}) {
+		t.Error(v)
+	}
+	if status, err := node.Tick(); err != nil || status != Success {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`}) {
+		t.Error(v)
+	}
+	r.statuses[`a`] = Failure
+	r.statuses[`c`] = Failure
+	if status, err := node.Tick(); err != nil || status != Failure {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `tick b`, `tick c`}) {
+		t.Error(v)
+	}
+	if status, err := SelectorWithMemory()(nil); err != nil || status != Failure {
+		t.Error(status, err)
+	}
+	if kind := SelectorWithMemory().Kind(); kind != `SelectorWithMemory` {
+		t.Error(kind)
+	}
+}
+
+func TestSequenceWithMemory_preempted(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`cond`: Success, `step1`: Success, `step2`: Running}}
+	node := New(
+		ReactiveSequence(),
+		r.node(`cond`),
+		New(SequenceWithMemory(), r.node(`step1`), r.node(`step2`)),
+	)
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick cond`, `tick step1`, `tick step2`}) {
+		t.Error(v)
+	}
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick cond`, `tick step2`}) {
+		t.Error(v)
+	}
+	r.statuses[`cond`] = Failure
+	if status, err := node.Tick(); err != nil || status != Failure {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick cond`, `halt step2`}) {
+		t.Error(v)
+	}
+	// re-entering starts from the first step
+	r.statuses[`cond`] = Success
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick cond`, `tick step1`, `tick step2`}) {
+		t.Error(v)
+	}
+}
+
+func TestSelectorWithMemory_halt(t *testing.T) {
+	r := &haltRecorder{statuses: map[string]Status{`a`: Failure, `b`: Running, `c`: Success}}
+	node := New(SelectorWithMemory(), r.node(`a`), r.node(`b`), r.node(`c`))
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	r.take()
+	node.Halt()
+	if v := r.take(); !reflect.DeepEqual(v, []string{`halt b`}) {
+		t.Error(v)
+	}
+	node.Halt()
+	if v := r.take(); v != nil {
+		t.Error(v)
+	}
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if v := r.take(); !reflect.DeepEqual(v, []string{`tick a`, `tick b`}) {
+		t.Error(v)
+	}
+}
@@ -0,0 +1,101 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+// RepeatN generates a stateful Tick which will repeat a tick until it has succeeded n times (returning success), or
+// until the first error (returning the error) or failure (returning failure), returning running in the interim, i.e.
+// each repetition will occur on a subsequent tick. A negative n will repeat indefinitely, and an n of 0 will succeed
+// without ticking. Halting the node (see Node.Halt) will reset the count. Nil will be returned if tick is nil.
+func RepeatN(n int, tick Tick) Tick {
+	if tick == nil {
+		return nil
+	}
+	var count int
+	return Tick(func(children []Node) (Status, error) {
+		if n == 0 {
+			return Success, nil
+		}
+		status, err := tick(children)
+		if err != nil {
+			count = 0
+			return Failure, err
+		}
+		switch status {
+		case Running:
+			return Running, nil
+		case Success:
+			count++
+			if n > 0 && count >= n {
+				count = 0
+				return Success, nil
+			}
+			return Running, nil
+		default:
+			count = 0
+			return Failure, nil
+		}
+	}).WithHalt(func(children []Node) {
+		count = 0
+		tick.Halt(children)
+	})
+}
+
+// RepeatUntilFailure wraps a tick such that success will be returned as running, and failure as success, i.e. it
+// will be repeated (on each subsequent tick) until it fails, note that any error or invalid status will still result
+// in a failure. Nil will be returned if tick is nil.
+func RepeatUntilFailure(tick Tick) Tick {
+	if tick == nil {
+		return nil
+	}
+	return decorate(tick, func(children []Node) (Status, error) {
+		status, err := tick(children)
+		if err != nil {
+			return Failure, err
+		}
+		switch status {
+		case Running, Success:
+			return Running, nil
+		case Failure:
+			return Success, nil
+		default:
+			return Failure, nil
+		}
+	})
+}
+
+// RepeatUntilSuccess wraps a tick such that failure will be returned as running, i.e. it will be repeated (on each
+// subsequent tick) until it succeeds, note that any error or invalid status will still result in a failure. Nil will
+// be returned if tick is nil.
+func RepeatUntilSuccess(tick Tick) Tick {
+	if tick == nil {
+		return nil
+	}
+	return decorate(tick, func(children []Node) (Status, error) {
+		status, err := tick(children)
+		if err != nil {
+			return Failure, err
+		}
+		switch status {
+		case Running, Failure:
+			return Running, nil
+		case Success:
+			return Success, nil
+		default:
+			return Failure, nil
+		}
+	})
+}
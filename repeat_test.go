@@ -0,0 +1,173 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"errors"
+	"testing"
+)
+
+// statusSequence returns a tick that returns each of the given statuses in turn, cycling, counting each call
+func statusSequence(count *int, statuses ...Status) Tick {
+	return func(children []Node) (Status, error) {
+		status := statuses[*count%len(statuses)]
+		*count++
+		return status, nil
+	}
+}
+
+func TestRepeatN(t *testing.T) {
+	var count int
+	tick := RepeatN(3, statusSequence(&count, Success, Running, Success, Success))
+	for i, expected := range []Status{Running, Running, Running, Success, Running} {
+		if status, err := tick(nil); err != nil || status != expected {
+			t.Fatal(i, status, err)
+		}
+	}
+	if count != 5 {
+		t.Error(count)
+	}
+}
+
+func TestRepeatN_failure(t *testing.T) {
+	var count int
+	tick := RepeatN(3, statusSequence(&count, Success, Failure, Success, Success, Success))
+	for i, expected := range []Status{Running, Failure, Running, Running, Success} {
+		if status, err := tick(nil); err != nil || status != expected {
+			t.Fatal(i, status, err)
+		}
+	}
+}
+
+func TestRepeatN_error(t *testing.T) {
+	var (
+		count int
+		e     = errors.New(`some_error`)
+	)
+	tick := RepeatN(2, func(children []Node) (Status, error) {
+		count++
+		if count == 2 {
+			return Success, e
+		}
+		return Success, nil
+	})
+	if status, err := tick(nil); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if status, err := tick(nil); err != e || status != Failure {
+		t.Fatal(status, err)
+	}
+	if status, err := tick(nil); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if status, err := tick(nil); err != nil || status != Success {
+		t.Fatal(status, err)
+	}
+}
+
+func TestRepeatN_halt(t *testing.T) {
+	var (
+		count  int
+		halted int
+		node   = New(RepeatN(2, statusSequence(&count, Success)), New(nil).WithHalt(func() { halted++ }))
+	)
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	node.Halt()
+	if halted != 1 {
+		t.Error(halted)
+	}
+	// the count was reset, so two more successes are required
+	for i, expected := range []Status{Running, Success} {
+		if status, err := node.Tick(); err != nil || status != expected {
+			t.Fatal(i, status, err)
+		}
+	}
+}
+
+func TestRepeatN_unlimited(t *testing.T) {
+	var count int
+	tick := RepeatN(-1, statusSequence(&count, Success))
+	for i := 0; i < 100; i++ {
+		if status, err := tick(nil); err != nil || status != Running {
+			t.Fatal(i, status, err)
+		}
+	}
+}
+
+func TestRepeatN_zero(t *testing.T) {
+	if status, err := RepeatN(0, func(children []Node) (Status, error) { panic(`unexpected tick`) })(nil); err != nil || status != Success {
+		t.Error(status, err)
+	}
+}
+
+func TestRepeatUntilFailure(t *testing.T) {
+	children := make([]Node, 3)
+	for _, tc := range []struct {
+		Status   Status
+		Err      error
+		Expected Status
+	}{
+		{Success, nil, Running},
+		{Running, nil, Running},
+		{Failure, nil, Success},
+		{1243145, nil, Failure},
+		{Success, errors.New(`some_err`), Failure},
+	} {
+		status, err := RepeatUntilFailure(func(children []Node) (Status, error) {
+			if len(children) != 3 {
+				t.Error(children)
+			}
+			return tc.Status, tc.Err
+		})(children)
+		if status != tc.Expected || err != tc.Err {
+			t.Error(tc.Status, tc.Err, status, err)
+		}
+	}
+}
+
+func TestRepeatUntilSuccess(t *testing.T) {
+	children := make([]Node, 3)
+	for _, tc := range []struct {
+		Status   Status
+		Err      error
+		Expected Status
+	}{
+		{Success, nil, Success},
+		{Running, nil, Running},
+		{Failure, nil, Running},
+		{1243145, nil, Failure},
+		{Success, errors.New(`some_err`), Failure},
+	} {
+		status, err := RepeatUntilSuccess(func(children []Node) (Status, error) {
+			if len(children) != 3 {
+				t.Error(children)
+			}
+			return tc.Status, tc.Err
+		})(children)
+		if status != tc.Expected || err != tc.Err {
+			t.Error(tc.Status, tc.Err, status, err)
+		}
+	}
+}
+
+func TestRepeat_nil(t *testing.T) {
+	if RepeatN(1, nil) != nil || RepeatUntilFailure(nil) != nil || RepeatUntilSuccess(nil) != nil {
+		t.Error(`expected nil`)
+	}
+}
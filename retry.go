@@ -0,0 +1,206 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"math"
+	"math/rand"
+	"sync/atomic"
+	"time"
+)
+
+type (
+	// RetryPolicy configures the behavior of Retry
+	RetryPolicy struct {
+		// Retries is the maximum number of retries, after the initial attempt, where a negative value is unlimited
+		Retries int
+		// Backoff configures the delay prior to each retry, where nil will retry on the next tick
+		Backoff Backoff
+		// Retryable may be used to retry on (the given) errors, which will otherwise be returned immediately
+		Retryable func(err error) bool
+		// Attempts may be used to expose the current attempt number, see also WithRetryAttempts
+		Attempts *RetryAttempts
+	}
+
+	// RetryOption configures the behavior of Retry, see also WithClock
+	RetryOption interface {
+		applyRetry(c *retryConfig)
+	}
+
+	retryConfig struct {
+		clock Clock
+	}
+
+	// Backoff models a delay policy, for use with Retry
+	Backoff interface {
+		// Delay returns the duration to wait prior to the given retry, where the first retry is 1
+		Delay(retry int) time.Duration
+	}
+
+	// BackoffFunc implements Backoff using a function
+	BackoffFunc func(retry int) time.Duration
+
+	// RetryAttempts records the attempt number of a Retry tick, and may be attached to a node, using
+	// WithRetryAttempts, or registered using UseValueProvider, for access via GetRetryAttempts
+	RetryAttempts struct {
+		n atomic.Int64
+	}
+
+	// vkRetryAttempts is the context key for GetRetryAttempts
+	vkRetryAttempts struct{}
+)
+
+// Retry wraps a tick such that it will be retried on failure, until it succeeds, or the policy's retries are exhausted,
+// in which case the last result will be returned. Errors are only retried if the policy's Retryable predicate returns
+// true. Retry will return running, rather than block, while waiting for any backoff delay (measured using the clock,
+// see WithClock), and will always return running between attempts. Halting the node (see Node.Halt) will reset the
+// attempts, and any backoff, such that the next tick will be the initial attempt. Nil will be returned if tick is nil.
+func Retry(tick Tick, policy RetryPolicy, options ...RetryOption) Tick {
+	if tick == nil {
+		return nil
+	}
+	var c retryConfig
+	for _, o := range options {
+		o.applyRetry(&c)
+	}
+	clock := orDefaultClock(c.clock)
+	var (
+		retry int
+		until time.Time
+	)
+	return Tick(func(children []Node) (Status, error) {
+		if !until.IsZero() {
+			if clock.Now().Before(until) {
+				return Running, nil
+			}
+			until = time.Time{}
+		}
+		if policy.Attempts != nil {
+			policy.Attempts.n.Store(int64(retry) + 1)
+		}
+		status, err := tick(children)
+		if err == nil {
+			switch status {
+			case Running:
+				return Running, nil
+			case Success:
+				retry = 0
+				return Success, nil
+			}
+		}
+		if (err != nil && (policy.Retryable == nil || !policy.Retryable(err))) ||
+			(policy.Retries >= 0 && retry >= policy.Retries) {
+			retry = 0
+			return Failure, err
+		}
+		retry++
+		if policy.Backoff != nil {
+			if d := policy.Backoff.Delay(retry); d > 0 {
+				until = clock.Now().Add(d)
+			}
+		}
+		return Running, nil
+	}).WithHalt(func(children []Node) {
+		retry, until = 0, time.Time{}
+		tick.Halt(children)
+	})
+}
+
+// Delay implements Backoff.Delay
+func (f BackoffFunc) Delay(retry int) time.Duration { return f(retry) }
+
+// FixedBackoff returns a Backoff that always delays by d
+func FixedBackoff(d time.Duration) Backoff {
+	return BackoffFunc(func(int) time.Duration { return d })
+}
+
+// ExponentialBackoff returns a Backoff that delays by base, doubling for each subsequent retry, up to max, where
+// a max <= 0 is unbounded
+func ExponentialBackoff(base, max time.Duration) Backoff {
+	return BackoffFunc(func(retry int) time.Duration {
+		d := base
+		for i := 1; i < retry && d > 0 && (max <= 0 || d < max); i++ {
+			if d > math.MaxInt64/2 {
+				return math.MaxInt64
+			}
+			d *= 2
+		}
+		if max > 0 && d > max {
+			return max
+		}
+		return d
+	})
+}
+
+// JitterBackoff wraps a Backoff, randomly reducing each delay by up to the given factor (clamped to [0, 1]), e.g. a
+// factor of 1 will result in delays in the range [0, d], using the provided source (a nil source will use global
+// math/rand), note that this function will return nil if backoff is nil
+func JitterBackoff(backoff Backoff, factor float64, source rand.Source) Backoff {
+	if backoff == nil {
+		return nil
+	}
+	if factor < 0 {
+		factor = 0
+	} else if factor > 1 {
+		factor = 1
+	}
+	if source == nil {
+		source = defaultSource{}
+	}
+	return BackoffFunc(func(retry int) time.Duration {
+		d := backoff.Delay(retry)
+		return d - time.Duration(rand.New(source).Float64()*factor*float64(d))
+	})
+}
+
+// Load returns the current (or last) attempt number, starting at 1, or 0 if there have been none
+func (a *RetryAttempts) Load() int {
+	if a == nil {
+		return 0
+	}
+	return int(a.n.Load())
+}
+
+// Value implements ValueProvider, providing the receiver for GetRetryAttempts
+func (a *RetryAttempts) Value(key any) (any, bool) {
+	if key == (vkRetryAttempts{}) {
+		if a == nil {
+			return nil, true
+		}
+		return a, true
+	}
+	return nil, false
+}
+
+// GetRetryAttempts retrieves the current (or last) attempt number of any attached RetryAttempts, or 0.
+//
+// This helper facilitates interoperability with external implementations of the [Valuer] interface.
+func GetRetryAttempts(n Valuer) int {
+	v, _ := n.Value(vkRetryAttempts{}).(*RetryAttempts)
+	return v.Load()
+}
+
+// WithRetryAttempts returns the value attachable with the attempts attached, for access via GetRetryAttempts, which
+// is also used by DefaultPrinterInspector.
+//
+// This helper facilitates interoperability with external implementations of the [ValueAttachable] interface.
+func WithRetryAttempts[T any](n ValueAttachable[T], attempts *RetryAttempts) T {
+	if attempts == nil {
+		return n.WithValue(vkRetryAttempts{}, nil)
+	}
+	return n.WithValue(vkRetryAttempts{}, attempts)
+}
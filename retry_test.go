@@ -0,0 +1,260 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetry_nil(t *testing.T) {
+	if v := Retry(nil, RetryPolicy{}); v != nil {
+		t.Error(`expected nil`)
+	}
+}
+
+func TestRetry_retries(t *testing.T) {
+	var (
+		count    int
+		statuses = []Status{Failure, Failure, Running, Failure, Success}
+		tick     = Retry(func(children []Node) (Status, error) {
+			if len(children) != 2 {
+				t.Error(children)
+			}
+			status := statuses[count%len(statuses)]
+			count++
+			return status, nil
+		}, RetryPolicy{Retries: 3})
+		children = make([]Node, 2)
+	)
+	for i, expected := range []Status{Running, Running, Running, Running, Success, Running} {
+		if status, err := tick(children); err != nil || status != expected {
+			t.Fatal(i, status, err)
+		}
+	}
+	if count != 6 {
+		t.Error(count)
+	}
+}
+
+func TestRetry_exhausted(t *testing.T) {
+	var (
+		count    int
+		attempts RetryAttempts
+		tick     = Retry(func(children []Node) (Status, error) {
+			count++
+			return Failure, nil
+		}, RetryPolicy{Retries: 2, Attempts: &attempts})
+	)
+	for i, expected := range []Status{Running, Running, Failure, Running, Running, Failure} {
+		if status, err := tick(nil); err != nil || status != expected {
+			t.Fatal(i, status, err)
+		}
+		if v := attempts.Load(); v != i%3+1 {
+			t.Error(i, v)
+		}
+	}
+	if count != 6 {
+		t.Error(count)
+	}
+}
+
+func TestRetry_halt(t *testing.T) {
+	var (
+		count    int
+		halted   int
+		attempts RetryAttempts
+		node     = New(
+			Retry(func(children []Node) (Status, error) {
+				count++
+				return Failure, nil
+			}, RetryPolicy{Retries: 2, Backoff: FixedBackoff(time.Hour), Attempts: &attempts}),
+			New(nil).WithHalt(func() { halted++ }),
+		)
+	)
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	// waiting for the backoff
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if count != 1 || attempts.Load() != 1 {
+		t.Fatal(count, attempts.Load())
+	}
+	node.Halt()
+	if halted != 1 {
+		t.Error(halted)
+	}
+	// re-entering is the initial attempt, without any backoff
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if count != 2 || attempts.Load() != 1 {
+		t.Error(count, attempts.Load())
+	}
+}
+
+func TestRetry_unlimited(t *testing.T) {
+	var count int
+	tick := Retry(func(children []Node) (Status, error) {
+		count++
+		if count == 100 {
+			return Success, nil
+		}
+		return Failure, nil
+	}, RetryPolicy{Retries: -1})
+	for i := 1; i < 100; i++ {
+		if status, err := tick(nil); err != nil || status != Running {
+			t.Fatal(i, status, err)
+		}
+	}
+	if status, err := tick(nil); err != nil || status != Success {
+		t.Fatal(status, err)
+	}
+}
+
+func TestRetry_errors(t *testing.T) {
+	var (
+		retryable = errors.New(`retryable`)
+		fatal     = errors.New(`fatal`)
+		errs      []error
+		tick      = Retry(func(children []Node) (Status, error) {
+			err := errs[0]
+			errs = errs[1:]
+			return Running, err
+		}, RetryPolicy{Retries: 5, Retryable: func(err error) bool { return errors.Is(err, retryable) }})
+	)
+	errs = []error{retryable, retryable, fatal}
+	for i, expected := range []error{nil, nil, fatal} {
+		if status, err := tick(nil); err != expected || (err == nil) != (status == Running) || (err != nil && status != Failure) {
+			t.Fatal(i, status, err)
+		}
+	}
+	if status, err := Retry(func(children []Node) (Status, error) { return Success, retryable }, RetryPolicy{Retries: 5})(nil); err != retryable || status != Failure {
+		t.Error(status, err)
+	}
+}
+
+func TestRetry_backoff(t *testing.T) {
+	var (
+		count int
+		delay = time.Millisecond * 50
+		tick  = Retry(func(children []Node) (Status, error) {
+			count++
+			return Failure, nil
+		}, RetryPolicy{Retries: 1, Backoff: FixedBackoff(delay)})
+	)
+	start := time.Now()
+	if status, err := tick(nil); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	for time.Since(start) < delay/2 {
+		if status, err := tick(nil); err != nil || status != Running {
+			t.Fatal(status, err)
+		}
+	}
+	if count != 1 {
+		t.Fatal(count)
+	}
+	time.Sleep(delay)
+	if status, err := tick(nil); err != nil || status != Failure {
+		t.Fatal(status, err)
+	}
+	if count != 2 {
+		t.Error(count)
+	}
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Backoff  Backoff
+		Expected []time.Duration
+	}{
+		{`unbounded`, ExponentialBackoff(time.Second, 0), []time.Duration{time.Second, time.Second * 2, time.Second * 4, time.Second * 8}},
+		{`bounded`, ExponentialBackoff(time.Second, time.Second*5), []time.Duration{time.Second, time.Second * 2, time.Second * 4, time.Second * 5, time.Second * 5}},
+		{`zero`, ExponentialBackoff(0, time.Second), []time.Duration{0, 0, 0}},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			for i, expected := range tc.Expected {
+				if d := tc.Backoff.Delay(i + 1); d != expected {
+					t.Error(i, d)
+				}
+			}
+		})
+	}
+	if d := ExponentialBackoff(time.Second, 0).Delay(1000); d != math.MaxInt64 {
+		t.Error(d)
+	}
+}
+
+func TestJitterBackoff(t *testing.T) {
+	if JitterBackoff(nil, 1, nil) != nil {
+		t.Error(`expected nil`)
+	}
+	backoff := JitterBackoff(FixedBackoff(time.Second), 0.5, rand.NewSource(1))
+	for i := 1; i < 100; i++ {
+		if d := backoff.Delay(i); d < time.Second/2 || d > time.Second {
+			t.Fatal(i, d)
+		}
+	}
+	if d := JitterBackoff(FixedBackoff(time.Second), -1, nil).Delay(1); d != time.Second {
+		t.Error(d)
+	}
+	if d := JitterBackoff(FixedBackoff(time.Second), 2, nil).Delay(1); d < 0 || d > time.Second {
+		t.Error(d)
+	}
+}
+
+func TestGetRetryAttempts(t *testing.T) {
+	var attempts RetryAttempts
+	node := WithRetryAttempts[Node](New(Retry(func(children []Node) (Status, error) { return Failure, nil }, RetryPolicy{
+		Retries:  -1,
+		Attempts: &attempts,
+	})), &attempts)
+	if v := GetRetryAttempts(node); v != 0 {
+		t.Error(v)
+	}
+	if s := node.String(); strings.Contains(s, `attempt`) {
+		t.Error(s)
+	}
+	for i := 0; i < 2; i++ {
+		if status, err := node.Tick(); err != nil || status != Running {
+			t.Fatal(status, err)
+		}
+	}
+	if v := GetRetryAttempts(node); v != 2 {
+		t.Error(v)
+	}
+	if s := node.String(); !strings.Contains(s, `TestGetRetryAttempts (attempt 2) | `) {
+		t.Error(s)
+	}
+	if v := GetRetryAttempts(WithRetryAttempts[Node](node, nil)); v != 0 {
+		t.Error(v)
+	}
+	if v, ok := (*RetryAttempts)(nil).Value(vkRetryAttempts{}); v != nil || !ok {
+		t.Error(v, ok)
+	}
+	if v, ok := attempts.Value(vkName{}); v != nil || ok {
+		t.Error(v, ok)
+	}
+}
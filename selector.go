@@ -17,7 +17,8 @@
 package behaviortree
 
 // Selector is a tick implementation that ticks each child sequentially, until the the first error (returning the
-// error), the first non-failure status (returning the status), or all children are ticked (returning failure)
+// error), the first non-failure status (returning the status), or all children are ticked (returning failure).
+// Children that are skipped aren't halted, see HaltPreempted, and ReactiveSelector.
 func Selector(children []Node) (Status, error) {
 	for _, c := range children {
 		status, err := c.Tick()
@@ -17,7 +17,8 @@
 package behaviortree
 
 // Sequence is a tick implementation that ticks each child sequentially, until the the first error (returning the
-// error), the first non-success status (returning the status), or all children are ticked (returning success)
+// error), the first non-success status (returning the status), or all children are ticked (returning success).
+// Children that are skipped aren't halted, see HaltPreempted, and ReactiveSequence.
 func Sequence(children []Node) (Status, error) {
 	for _, c := range children {
 		status, err := c.Tick()
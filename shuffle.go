@@ -30,11 +30,11 @@ func Shuffle(tick Tick, source rand.Source) Tick {
 	if source == nil {
 		source = defaultSource{}
 	}
-	return func(children []Node) (Status, error) {
+	return decorate(tick, func(children []Node) (Status, error) {
 		children = copyNodes(children)
 		rand.New(source).Shuffle(len(children), func(i, j int) { children[i], children[j] = children[j], children[i] })
 		return tick(children)
-	}
+	})
 }
 
 type defaultSource struct{ rand.Source }
@@ -0,0 +1,178 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+type (
+	// StatsReporter may be implemented by a Ticker to expose statistics about its ticks, and is implemented by the
+	// tickers provided by this package, see also TickerStats
+	StatsReporter interface {
+		// Stats returns a snapshot of the statistics
+		Stats() TickerStats
+	}
+
+	// TickerStats models statistics about the ticks of a Ticker, see StatsReporter
+	TickerStats struct {
+		// Interval is the configured interval of the ticker, or zero if it doesn't tick periodically
+		Interval time.Duration
+		// Ticks is the number of completed ticks
+		Ticks int64
+		// Overruns is the number of ticks that took longer than Interval
+		Overruns int64
+		// Dropped is the estimated number of ticks that were skipped, due to overruns
+		Dropped int64
+		// Last is the duration of the most recent tick
+		Last time.Duration
+		// Total is the sum of the duration of every tick
+		Total time.Duration
+		// Max is the longest duration of any tick
+		Max time.Duration
+		// Buckets is a cumulative histogram of tick durations, see StatsBuckets
+		Buckets []StatsBucket
+	}
+
+	// StatsBucket is a bucket of a cumulative histogram, see TickerStats
+	StatsBucket struct {
+		// UpperBound is the inclusive upper bound of the bucket
+		UpperBound time.Duration
+		// Count is the number of ticks with a duration <= UpperBound
+		Count int64
+	}
+
+	// tickStats records TickerStats, and is safe for concurrent use
+	tickStats struct {
+		mutex sync.Mutex
+		stats TickerStats
+	}
+)
+
+// StatsBuckets are the upper bounds of the TickerStats histogram, doubling from 10µs to ~42s, durations greater than
+// the last bound are counted only by TickerStats.Ticks.
+var StatsBuckets = func() (b []time.Duration) {
+	for d := time.Microsecond * 10; d < time.Minute; d *= 2 {
+		b = append(b, d)
+	}
+	return
+}()
+
+// Mean returns the average duration of a tick, or zero if there have been no ticks.
+func (s TickerStats) Mean() time.Duration {
+	if s.Ticks <= 0 {
+		return 0
+	}
+	return s.Total / time.Duration(s.Ticks)
+}
+
+// Percentile returns the estimated duration of the given percentile (in the range [0, 1]) of ticks, which will be
+// the upper bound of the histogram bucket containing that rank, capped at Max, or zero if there have been no ticks.
+func (s TickerStats) Percentile(p float64) time.Duration {
+	if s.Ticks <= 0 {
+		return 0
+	}
+	rank := int64(math.Ceil(p * float64(s.Ticks)))
+	if rank < 1 {
+		rank = 1
+	} else if rank > s.Ticks {
+		rank = s.Ticks
+	}
+	for _, b := range s.Buckets {
+		if b.Count >= rank {
+			if b.UpperBound < s.Max {
+				return b.UpperBound
+			}
+			return s.Max
+		}
+	}
+	return s.Max
+}
+
+// merge returns the combination of the receiver and the more recent stats o
+func (s TickerStats) merge(o TickerStats) TickerStats {
+	if o.Ticks == 0 {
+		o.Last = s.Last
+	}
+	o.Ticks += s.Ticks
+	o.Overruns += s.Overruns
+	o.Dropped += s.Dropped
+	o.Total += s.Total
+	if s.Max > o.Max {
+		o.Max = s.Max
+	}
+	if len(o.Buckets) == 0 {
+		o.Buckets = slices.Clone(s.Buckets)
+	} else if len(o.Buckets) == len(s.Buckets) {
+		o.Buckets = slices.Clone(o.Buckets)
+		for i := range o.Buckets {
+			o.Buckets[i].Count += s.Buckets[i].Count
+		}
+	}
+	return o
+}
+
+func newTickStats(interval time.Duration) *tickStats {
+	result := tickStats{stats: TickerStats{
+		Interval: interval,
+		Buckets:  make([]StatsBucket, len(StatsBuckets)),
+	}}
+	for i, d := range StatsBuckets {
+		result.stats.Buckets[i].UpperBound = d
+	}
+	return &result
+}
+
+// record adds a tick that took d
+func (x *tickStats) record(d time.Duration) {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+	s := &x.stats
+	s.Ticks++
+	s.Last = d
+	s.Total += d
+	if d > s.Max {
+		s.Max = d
+	}
+	if s.Interval > 0 && d > s.Interval {
+		s.Overruns++
+		// the first tick to elapse is buffered (see time.NewTicker), any others are dropped
+		s.Dropped += int64(d/s.Interval) - 1
+	}
+	for i := range s.Buckets {
+		if d <= s.Buckets[i].UpperBound {
+			s.Buckets[i].Count++
+		}
+	}
+}
+
+func (x *tickStats) ticks() int64 {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+	return x.stats.Ticks
+}
+
+func (x *tickStats) snapshot() TickerStats {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+	s := x.stats
+	s.Buckets = slices.Clone(s.Buckets)
+	return s
+}
@@ -0,0 +1,58 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+	"github.com/joeycumines/go-behaviortree/bttest"
+)
+
+func TestNewTicker_clockStats(t *testing.T) {
+	var (
+		c     = bttest.NewClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		ticks = make(chan struct{})
+		count int
+	)
+	ticker := bt.NewTicker(context.Background(), time.Second, bt.New(func(children []bt.Node) (bt.Status, error) {
+		count++
+		if count == 2 {
+			// overrun, by enough to drop a tick
+			c.Advance(time.Millisecond * 2500)
+		} else {
+			c.Advance(time.Millisecond * 100)
+		}
+		ticks <- struct{}{}
+		return bt.Success, nil
+	}), bt.WithClock(c))
+	c.Advance(time.Second)
+	<-ticks
+	c.Advance(time.Second)
+	<-ticks
+	// the tick that elapsed during the overrun was buffered
+	<-ticks
+	ticker.Stop()
+	<-ticker.Done()
+	stats := ticker.(bt.StatsReporter).Stats()
+	if stats.Interval != time.Second || stats.Ticks != 3 || stats.Overruns != 1 || stats.Dropped != 1 ||
+		stats.Last != time.Millisecond*100 || stats.Max != time.Millisecond*2500 || stats.Total != time.Millisecond*2700 {
+		t.Errorf(`%+v`, stats)
+	}
+}
@@ -0,0 +1,112 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTickerStats_empty(t *testing.T) {
+	s := newTickStats(time.Second).snapshot()
+	if s.Interval != time.Second || s.Ticks != 0 || s.Mean() != 0 || s.Percentile(0.5) != 0 || len(s.Buckets) != len(StatsBuckets) {
+		t.Error(s)
+	}
+}
+
+func TestTickerStats_record(t *testing.T) {
+	x := newTickStats(time.Millisecond * 10)
+	for _, d := range []time.Duration{
+		time.Microsecond * 5,
+		time.Microsecond * 15,
+		time.Microsecond * 15,
+		time.Millisecond * 15,
+		time.Millisecond * 35,
+	} {
+		x.record(d)
+	}
+	s := x.snapshot()
+	if s.Ticks != 5 || x.ticks() != 5 {
+		t.Error(s.Ticks)
+	}
+	if s.Overruns != 2 || s.Dropped != 2 {
+		t.Error(s.Overruns, s.Dropped)
+	}
+	if s.Last != time.Millisecond*35 || s.Max != time.Millisecond*35 {
+		t.Error(s.Last, s.Max)
+	}
+	if s.Total != time.Microsecond*50035 || s.Mean() != time.Microsecond*10007 {
+		t.Error(s.Total, s.Mean())
+	}
+	if s.Buckets[0].UpperBound != time.Microsecond*10 || s.Buckets[0].Count != 1 ||
+		s.Buckets[1].UpperBound != time.Microsecond*20 || s.Buckets[1].Count != 3 ||
+		s.Buckets[len(s.Buckets)-1].Count != 5 {
+		t.Error(s.Buckets)
+	}
+	for _, tc := range []struct {
+		p        float64
+		expected time.Duration
+	}{
+		{0, time.Microsecond * 10},
+		{0.2, time.Microsecond * 10},
+		{0.5, time.Microsecond * 20},
+		{0.8, time.Microsecond * 20480},
+		{0.99, time.Millisecond * 35},
+		{2, time.Millisecond * 35},
+	} {
+		if v := s.Percentile(tc.p); v != tc.expected {
+			t.Error(tc.p, v)
+		}
+	}
+
+	// snapshots must not share buckets
+	x.record(time.Microsecond)
+	if s.Buckets[0].Count != 1 {
+		t.Error(s.Buckets[0])
+	}
+}
+
+func TestTickerStats_Percentile_overflow(t *testing.T) {
+	x := newTickStats(0)
+	x.record(time.Hour)
+	if s := x.snapshot(); s.Percentile(0.5) != time.Hour || s.Overruns != 0 || s.Buckets[len(s.Buckets)-1].Count != 0 {
+		t.Error(s)
+	}
+}
+
+func TestTickerStats_merge(t *testing.T) {
+	a, b := newTickStats(time.Second), newTickStats(time.Minute)
+	a.record(time.Second * 3)
+	a.record(time.Millisecond)
+	s := TickerStats{}.merge(a.snapshot())
+	if s.Ticks != 2 || s.Last != time.Millisecond || len(s.Buckets) != len(StatsBuckets) {
+		t.Fatal(s)
+	}
+	s = s.merge(b.snapshot())
+	if s.Ticks != 2 || s.Last != time.Millisecond || s.Interval != time.Minute {
+		t.Fatal(s)
+	}
+	b.record(time.Second)
+	s = s.merge(b.snapshot())
+	if s.Ticks != 3 || s.Overruns != 1 || s.Dropped != 2 || s.Last != time.Second || s.Max != time.Second*3 ||
+		s.Total != time.Second*4+time.Millisecond || s.Buckets[len(s.Buckets)-1].Count != 3 {
+		t.Fatal(s)
+	}
+	if a.snapshot().Buckets[len(s.Buckets)-1].Count != 2 {
+		t.Error(`unexpected mutation`)
+	}
+}
@@ -0,0 +1,228 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+type (
+	// RestartMode configures when a supervised ticker will be restarted, see RestartPolicy
+	RestartMode int
+
+	// RestartPolicy configures the behavior of Supervise
+	RestartPolicy struct {
+		// Mode configures when the ticker will be restarted, defaulting to RestartNever
+		Mode RestartMode
+		// Backoff configures the delay prior to each restart, where the first restart (within Window) is 1, and nil
+		// will restart immediately
+		Backoff Backoff
+		// MaxRestarts is the maximum number of restarts within Window, where zero (or a negative value) is unlimited
+		MaxRestarts int
+		// Window is the duration over which MaxRestarts applies, where zero is the lifetime of the supervisor
+		Window time.Duration
+		// OnError will be called (if non-nil) with each error, from the factory or ticker, prior to any restart
+		OnError func(err error)
+	}
+
+	// SuperviseOption configures the behavior of Supervise, see also WithClock
+	SuperviseOption interface {
+		applySupervise(c *superviseConfig)
+	}
+
+	superviseConfig struct {
+		clock Clock
+	}
+
+	// supervisor is the Ticker implementation returned by Supervise
+	supervisor struct {
+		factory func() (Ticker, error)
+		policy  RestartPolicy
+		clock   Clock
+		done    chan struct{}
+		stop    chan struct{}
+		once    sync.Once
+		mutex   sync.Mutex
+		err     error
+		current Ticker
+		ticks   int64
+		stats   TickerStats
+	}
+)
+
+const (
+	// RestartNever will never restart the ticker
+	RestartNever RestartMode = iota
+	// RestartOnError will restart the ticker only if it stopped with an error, or the factory returned an error
+	RestartOnError
+	// RestartAlways will restart the ticker whenever it stops, other than via Ticker.Stop of the supervisor
+	RestartAlways
+)
+
+// Supervise returns a Ticker that runs a ticker, built using factory, rebuilding it (again using factory) whenever
+// it stops, as configured by policy, note that a panic will occur if factory is nil. Any Backoff and Window will be
+// measured using the clock, see WithClock.
+//
+// This is intended for use with a Manager, which will otherwise stop every ticker on the first error, meaning a
+// supervised ticker will only cause the manager to stop after it gives up, i.e. if it isn't restarted, due to the
+// Mode, or exceeding MaxRestarts within Window. The supervisor's Err will be the last error, from the factory or the
+// ticker, which may be nil. Errors that were handled via a restart are observable only via OnError.
+//
+// Stopping the supervisor will stop the current ticker, or cancel any pending restart, after which Err will be the
+// error of the current ticker, or the error that triggered the pending restart.
+func Supervise(factory func() (Ticker, error), policy RestartPolicy, options ...SuperviseOption) Ticker {
+	if factory == nil {
+		panic(errors.New("behaviortree.Supervise nil factory"))
+	}
+	var c superviseConfig
+	for _, o := range options {
+		o.applySupervise(&c)
+	}
+	result := &supervisor{
+		factory: factory,
+		policy:  policy,
+		clock:   orDefaultClock(c.clock),
+		done:    make(chan struct{}),
+		stop:    make(chan struct{}),
+	}
+	go result.run()
+	return result
+}
+
+func (s *supervisor) Done() <-chan struct{} {
+	return s.done
+}
+
+func (s *supervisor) Err() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.err
+}
+
+// Ticks implements TickCounter, returning the total across all restarts, for tickers that implement TickCounter
+func (s *supervisor) Ticks() int64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	ticks := s.ticks
+	if v, ok := s.current.(TickCounter); ok {
+		ticks += v.Ticks()
+	}
+	return ticks
+}
+
+// Stats implements StatsReporter, combining the stats of all restarts, for tickers that implement StatsReporter
+func (s *supervisor) Stats() TickerStats {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if v, ok := s.current.(StatsReporter); ok {
+		return s.stats.merge(v.Stats())
+	}
+	return s.stats
+}
+
+func (s *supervisor) Stop() {
+	s.once.Do(func() {
+		close(s.stop)
+	})
+}
+
+func (s *supervisor) run() {
+	var (
+		err      error
+		restarts []time.Time
+	)
+	defer func() {
+		s.mutex.Lock()
+		s.err = err
+		s.mutex.Unlock()
+		close(s.done)
+	}()
+	for {
+		var ticker Ticker
+		if ticker, err = s.factory(); err == nil && ticker == nil {
+			err = errors.New("behaviortree.Supervise nil ticker")
+		}
+		if err == nil {
+			s.mutex.Lock()
+			s.current = ticker
+			s.mutex.Unlock()
+			select {
+			case <-ticker.Done():
+			case <-s.stop:
+			}
+			ticker.Stop()
+			<-ticker.Done()
+			err = ticker.Err()
+			s.mutex.Lock()
+			if v, ok := ticker.(TickCounter); ok {
+				s.ticks += v.Ticks()
+			}
+			if v, ok := ticker.(StatsReporter); ok {
+				s.stats = s.stats.merge(v.Stats())
+			}
+			s.current = nil
+			s.mutex.Unlock()
+		}
+
+		select {
+		case <-s.stop:
+			return
+		default:
+		}
+
+		if err != nil && s.policy.OnError != nil {
+			s.policy.OnError(err)
+		}
+
+		switch s.policy.Mode {
+		case RestartAlways:
+		case RestartOnError:
+			if err == nil {
+				return
+			}
+		default:
+			return
+		}
+
+		now := s.clock.Now()
+		if s.policy.Window > 0 {
+			var i int
+			for i < len(restarts) && now.Sub(restarts[i]) >= s.policy.Window {
+				i++
+			}
+			restarts = restarts[i:]
+		}
+		if s.policy.MaxRestarts > 0 && len(restarts) >= s.policy.MaxRestarts {
+			return
+		}
+		restarts = append(restarts, now)
+
+		if s.policy.Backoff != nil {
+			if d := s.policy.Backoff.Delay(len(restarts)); d > 0 {
+				timer := s.clock.NewTimer(d)
+				select {
+				case <-timer.C():
+				case <-s.stop:
+					timer.Stop()
+					return
+				}
+			}
+		}
+	}
+}
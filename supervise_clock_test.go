@@ -0,0 +1,77 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+	"github.com/joeycumines/go-behaviortree/bttest"
+)
+
+func TestSupervise_clock(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		window   time.Duration
+		expected []time.Duration
+	}{
+		// restarts are delayed 1s then 2s, and the third would exceed the limit
+		{`lifetime`, 0, []time.Duration{0, time.Second, time.Second * 3}},
+		// restarts older than the window no longer count towards the limit
+		{`window`, time.Second * 2, []time.Duration{0, time.Second, time.Second * 3, time.Second * 4, time.Second * 6}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				epoch = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+				c     = bttest.NewClock(epoch)
+				calls = make(chan time.Time, 10)
+			)
+			ticker := bt.Supervise(func() (bt.Ticker, error) {
+				calls <- c.Now()
+				return nil, errors.New(`some error`)
+			}, bt.RestartPolicy{
+				Mode:        bt.RestartOnError,
+				Backoff:     bt.ExponentialBackoff(time.Second, time.Minute),
+				MaxRestarts: 2,
+				Window:      tc.window,
+			}, bt.WithClock(c))
+			for _, expected := range tc.expected {
+				for len(calls) == 0 {
+					if c.Waiters() == 1 {
+						c.Advance(time.Second)
+					}
+					time.Sleep(time.Millisecond)
+				}
+				if v := <-calls; !v.Equal(epoch.Add(expected)) {
+					t.Fatal(expected, v)
+				}
+			}
+			if tc.window != 0 {
+				ticker.Stop()
+			}
+			<-ticker.Done()
+			if err := ticker.Err(); err == nil || err.Error() != `some error` {
+				t.Error(err)
+			}
+			if c.Waiters() != 0 {
+				t.Error(c.Waiters())
+			}
+		})
+	}
+}
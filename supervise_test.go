@@ -0,0 +1,241 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// stoppedTicker returns a ticker that has already stopped, with the given error
+func stoppedTicker(err error) Ticker {
+	done := make(chan struct{})
+	close(done)
+	return mockTicker{
+		done: func() <-chan struct{} { return done },
+		err:  func() error { return err },
+		stop: func() {},
+	}
+}
+
+// blockingTicker returns a ticker that runs until stopped
+func blockingTicker() Ticker {
+	var (
+		once sync.Once
+		done = make(chan struct{})
+	)
+	return mockTicker{
+		done: func() <-chan struct{} { return done },
+		err:  func() error { return nil },
+		stop: func() { once.Do(func() { close(done) }) },
+	}
+}
+
+// countedTicker implements TickCounter, with a single tick
+type countedTicker struct{ Ticker }
+
+func (countedTicker) Ticks() int64 { return 1 }
+
+func TestSupervise(t *testing.T) {
+	someErr := errors.New(`some error`)
+	for _, tc := range []struct {
+		name    string
+		policy  RestartPolicy
+		results []error
+		calls   int
+		errs    int
+		err     error
+	}{
+		{`never error`, RestartPolicy{MaxRestarts: -1}, []error{someErr}, 1, 1, someErr},
+		{`never success`, RestartPolicy{MaxRestarts: -1}, []error{nil}, 1, 0, nil},
+		{`on error limit`, RestartPolicy{Mode: RestartOnError, MaxRestarts: 2}, []error{someErr}, 3, 3, someErr},
+		{`on error zero value`, RestartPolicy{Mode: RestartOnError}, []error{someErr, someErr, nil}, 3, 2, nil},
+		{`on error success`, RestartPolicy{Mode: RestartOnError, MaxRestarts: -1}, []error{someErr, someErr, nil}, 3, 2, nil},
+		{`always limit`, RestartPolicy{Mode: RestartAlways, MaxRestarts: 2}, []error{nil}, 3, 0, nil},
+		{`always mixed`, RestartPolicy{Mode: RestartAlways, MaxRestarts: 3}, []error{nil, someErr}, 4, 2, someErr},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			defer checkNumGoroutines(t)(false, 0)
+			var calls, errs int
+			tc.policy.OnError = func(err error) {
+				if err != someErr {
+					t.Error(err)
+				}
+				errs++
+			}
+			ticker := Supervise(func() (Ticker, error) {
+				err := tc.results[calls%len(tc.results)]
+				calls++
+				return countedTicker{stoppedTicker(err)}, nil
+			}, tc.policy)
+			<-ticker.Done()
+			if ticks := ticker.(TickCounter).Ticks(); ticks != int64(tc.calls) {
+				t.Error(ticks)
+			}
+			if err := ticker.Err(); err != tc.err {
+				t.Error(err)
+			}
+			if calls != tc.calls || errs != tc.errs {
+				t.Error(calls, errs)
+			}
+		})
+	}
+}
+
+func TestSupervise_factoryError(t *testing.T) {
+	defer checkNumGoroutines(t)(false, 0)
+	var (
+		calls int
+		errs  []string
+	)
+	ticker := Supervise(func() (Ticker, error) {
+		calls++
+		switch calls {
+		case 1:
+			return nil, errors.New(`some error`)
+		case 2:
+			return nil, nil
+		default:
+			return stoppedTicker(nil), nil
+		}
+	}, RestartPolicy{
+		Mode:        RestartOnError,
+		MaxRestarts: -1,
+		OnError:     func(err error) { errs = append(errs, err.Error()) },
+	})
+	<-ticker.Done()
+	if err := ticker.Err(); err != nil {
+		t.Error(err)
+	}
+	if calls != 3 || len(errs) != 2 || errs[0] != `some error` || errs[1] != `behaviortree.Supervise nil ticker` {
+		t.Error(calls, errs)
+	}
+}
+
+func TestSupervise_Stop(t *testing.T) {
+	defer checkNumGoroutines(t)(false, 0)
+	var calls int
+	ticker := Supervise(func() (Ticker, error) {
+		calls++
+		return blockingTicker(), nil
+	}, RestartPolicy{Mode: RestartAlways, MaxRestarts: -1})
+	select {
+	case <-ticker.Done():
+		t.Fatal(`unexpected done`)
+	case <-time.After(time.Millisecond * 50):
+	}
+	ticker.Stop()
+	ticker.Stop()
+	<-ticker.Done()
+	if err := ticker.Err(); err != nil {
+		t.Error(err)
+	}
+	if calls != 1 {
+		t.Error(calls)
+	}
+}
+
+func TestSupervise_Manager(t *testing.T) {
+	defer checkNumGoroutines(t)(false, 0)
+	var (
+		m       = NewManager()
+		mutex   sync.Mutex
+		calls   int
+		errs    int
+		someErr = errors.New(`some error`)
+	)
+	if err := m.Add(blockingTicker()); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Add(Supervise(func() (Ticker, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		calls++
+		if calls > 3 {
+			return blockingTicker(), nil
+		}
+		return stoppedTicker(someErr), nil
+	}, RestartPolicy{
+		Mode:        RestartOnError,
+		MaxRestarts: 5,
+		OnError: func(err error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			errs++
+		},
+	})); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Millisecond * 50)
+	select {
+	case <-m.Done():
+		t.Fatal(`unexpected done`)
+	default:
+	}
+	mutex.Lock()
+	if calls != 4 || errs != 3 {
+		t.Error(calls, errs)
+	}
+	mutex.Unlock()
+	m.Stop()
+	<-m.Done()
+	if err := m.Err(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSupervise_nilFactory(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil || r.(error).Error() != `behaviortree.Supervise nil factory` {
+			t.Error(r)
+		}
+	}()
+	Supervise(nil, RestartPolicy{})
+}
+
+// statsTicker implements StatsReporter, with a single tick
+type statsTicker struct{ Ticker }
+
+func (statsTicker) Stats() TickerStats {
+	x := newTickStats(time.Second)
+	x.record(time.Millisecond)
+	return x.snapshot()
+}
+
+func TestSupervise_Stats(t *testing.T) {
+	defer checkNumGoroutines(t)(false, 0)
+	var calls int
+	ticker := Supervise(func() (Ticker, error) {
+		calls++
+		if calls == 3 {
+			return statsTicker{blockingTicker()}, nil
+		}
+		return statsTicker{stoppedTicker(nil)}, nil
+	}, RestartPolicy{Mode: RestartAlways, MaxRestarts: -1})
+	defer ticker.Stop()
+	for {
+		if stats := ticker.(StatsReporter).Stats(); stats.Ticks == 3 {
+			if stats.Total != time.Millisecond*3 || stats.Interval != time.Second {
+				t.Error(stats)
+			}
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -22,6 +22,8 @@ package behaviortree
 // or first running status will be returned (if any). Otherwise, the result will be either that of the statement
 // corresponding to the first successful condition, or success.
 //
+// Children that are skipped aren't halted, e.g. the statement of a case that no longer matches, see HaltPreempted.
+//
 // This implementation is compatible with both Memorize and Sync.
 func Switch(children []Node) (Status, error) {
 	for i := 0; i < len(children); i += 2 {
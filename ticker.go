@@ -36,12 +36,21 @@ type (
 		Stop()
 	}
 
+	// TickCounter may be implemented by a Ticker to expose the number of times it has ticked, and is implemented by
+	// the tickers provided by this package, see also NamedManager.List
+	TickCounter interface {
+		// Ticks returns the number of completed ticks
+		Ticks() int64
+	}
+
 	// tickerCore is the base ticker implementation
 	tickerCore struct {
 		ctx    context.Context
 		cancel context.CancelFunc
 		node   Node
-		ticker *time.Ticker
+		clock  Clock
+		ticker ClockTicker
+		stats  *tickStats
 		done   chan struct{}
 		stop   chan struct{}
 		once   sync.Once
@@ -53,6 +62,15 @@ type (
 	tickerStopOnFailure struct {
 		Ticker
 	}
+
+	// TickerOption configures the behavior of NewTicker and NewTickerStopOnFailure, see also WithClock
+	TickerOption interface {
+		applyTicker(c *tickerConfig)
+	}
+
+	tickerConfig struct {
+		clock Clock
+	}
 )
 
 var (
@@ -67,7 +85,7 @@ var (
 // The node will tick until the first error or Ticker.Stop is called, or context is canceled, after which any error
 // will be made available via Ticker.Err, before closure of the done channel, indicating that all resources have been
 // freed, and any error is available.
-func NewTicker(ctx context.Context, duration time.Duration, node Node) Ticker {
+func NewTicker(ctx context.Context, duration time.Duration, node Node, options ...TickerOption) Ticker {
 	if ctx == nil {
 		panic(errors.New("behaviortree.NewTicker nil context"))
 	}
@@ -80,9 +98,18 @@ func NewTicker(ctx context.Context, duration time.Duration, node Node) Ticker {
 		panic(errors.New("behaviortree.NewTicker nil node"))
 	}
 
+	var c tickerConfig
+	for _, o := range options {
+		o.applyTicker(&c)
+	}
+
+	clock := orDefaultClock(c.clock)
+
 	result := &tickerCore{
 		node:   node,
-		ticker: time.NewTicker(duration),
+		clock:  clock,
+		ticker: clock.NewTicker(duration),
+		stats:  newTickStats(duration),
 		done:   make(chan struct{}),
 		stop:   make(chan struct{}),
 	}
@@ -98,7 +125,7 @@ func NewTicker(ctx context.Context, duration time.Duration, node Node) Ticker {
 // UNLESS there was an actual error returned, it's built on top of the same core implementation provided by NewTicker,
 // and uses that function directly, note that it will panic if the node is nil, the panic cases for NewTicker also
 // apply.
-func NewTickerStopOnFailure(ctx context.Context, duration time.Duration, node Node) Ticker {
+func NewTickerStopOnFailure(ctx context.Context, duration time.Duration, node Node, options ...TickerOption) Ticker {
 	if node == nil {
 		panic(errors.New("behaviortree.NewTickerStopOnFailure nil node"))
 	}
@@ -120,6 +147,7 @@ func NewTickerStopOnFailure(ctx context.Context, duration time.Duration, node No
 					return status, err
 				}, children
 			},
+			options...,
 		),
 	}
 }
@@ -134,8 +162,10 @@ TickLoop:
 			break TickLoop
 		case <-t.stop:
 			break TickLoop
-		case <-t.ticker.C:
+		case <-t.ticker.C():
+			start := t.clock.Now()
 			_, err = t.node.Tick()
+			t.stats.record(t.clock.Now().Sub(start))
 		}
 	}
 	t.mutex.Lock()
@@ -163,6 +193,22 @@ func (t *tickerCore) Stop() {
 	})
 }
 
+func (t *tickerCore) Ticks() int64 {
+	return t.stats.ticks()
+}
+
+func (t *tickerCore) Stats() TickerStats {
+	return t.stats.snapshot()
+}
+
+func (t tickerStopOnFailure) Ticks() int64 {
+	return t.Ticker.(TickCounter).Ticks()
+}
+
+func (t tickerStopOnFailure) Stats() TickerStats {
+	return t.Ticker.(StatsReporter).Stats()
+}
+
 func (t tickerStopOnFailure) Err() error {
 	err := t.Ticker.Err()
 	if err == errExitOnFailure {
@@ -277,6 +277,9 @@ func TestNewTickerStopOnFailure_success(t *testing.T) {
 	if err := ticker.Err(); err != nil {
 		t.Error(err)
 	}
+	if ticks := ticker.(TickCounter).Ticks(); ticks != 5 {
+		t.Error(ticks)
+	}
 }
 
 func TestNewTickerStopOnFailure_error(t *testing.T) {
@@ -0,0 +1,111 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"errors"
+	"time"
+)
+
+type (
+	// TimeoutOption configures the behavior of Timeout, see also WithClock
+	TimeoutOption interface {
+		applyTimeout(c *timeoutConfig)
+	}
+
+	timeoutConfig struct {
+		err   error
+		ctx   *Context
+		clock Clock
+	}
+
+	timeoutOptionFunc func(c *timeoutConfig)
+)
+
+var (
+	// ErrTimeout may be used with WithTimeoutError, to cause Timeout to return an error, rather than just failure.
+	ErrTimeout = errors.New(`behaviortree.Timeout deadline exceeded`)
+)
+
+// Timeout wraps a tick such that it will fail, if it is still running, once the given duration has elapsed since it
+// first returned running, per "execution", defined as the period until the first non-running status. On timeout, the
+// tick won't be ticked again, any children will be halted (see Node.Halt), and the context (if configured, see
+// WithTimeoutContext) will be canceled. Halting the node will likewise end the execution, such that the next tick
+// starts a new one, with a new deadline. Nil will be returned if tick is nil.
+func Timeout(d time.Duration, tick Tick, options ...TimeoutOption) Tick {
+	if tick == nil {
+		return nil
+	}
+	var c timeoutConfig
+	for _, o := range options {
+		o.applyTimeout(&c)
+	}
+	clock := orDefaultClock(c.clock)
+	var (
+		started  bool
+		deadline time.Time
+	)
+	// end ends the execution, if started
+	end := func() {
+		if !started {
+			return
+		}
+		started, deadline = false, time.Time{}
+		if c.ctx != nil {
+			_, _ = c.ctx.Cancel(nil)
+		}
+	}
+	return Tick(func(children []Node) (Status, error) {
+		if !deadline.IsZero() && !clock.Now().Before(deadline) {
+			end()
+			tick.Halt(children)
+			return Failure, c.err
+		}
+		if !started {
+			started = true
+			if c.ctx != nil {
+				_, _ = c.ctx.Init(nil)
+			}
+		}
+		status, err := tick(children)
+		if err != nil || status != Running {
+			end()
+			return status, err
+		}
+		if deadline.IsZero() {
+			deadline = clock.Now().Add(d)
+		}
+		return Running, nil
+	}).WithHalt(func(children []Node) {
+		end()
+		tick.Halt(children)
+	})
+}
+
+// WithTimeoutError configures Timeout to return the given error on timeout, e.g. ErrTimeout, the default is nil.
+func WithTimeoutError(err error) TimeoutOption {
+	return timeoutOptionFunc(func(c *timeoutConfig) { c.err = err })
+}
+
+// WithTimeoutContext configures Timeout to (re)initialise ctx at the start of each execution, canceling it on
+// timeout, or completion, meaning any ticks built using ctx.Tick will observe the cancellation. Note that ctx should
+// not be shared with anything other than the wrapped tick, and that it need not be initialised by other means.
+func WithTimeoutContext(ctx *Context) TimeoutOption {
+	return timeoutOptionFunc(func(c *timeoutConfig) { c.ctx = ctx })
+}
+
+func (f timeoutOptionFunc) applyTimeout(c *timeoutConfig) { f(c) }
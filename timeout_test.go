@@ -0,0 +1,190 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTimeout_nil(t *testing.T) {
+	if v := Timeout(time.Second, nil); v != nil {
+		t.Error(`expected nil`)
+	}
+}
+
+func TestTimeout_completes(t *testing.T) {
+	var (
+		count int
+		ctx   context.Context
+		btCtx = new(Context)
+		e     = errors.New(`some_error`)
+		tick  = Timeout(time.Hour, btCtx.Tick(func(c context.Context, children []Node) (Status, error) {
+			if ctx != nil && ctx != c {
+				t.Error(`expected same context`)
+			}
+			ctx = c
+			count++
+			switch count {
+			case 1, 2:
+				return Running, nil
+			case 3:
+				return Success, nil
+			default:
+				return Running, e
+			}
+		}), WithTimeoutContext(btCtx))
+	)
+	for i, expected := range []Status{Running, Running, Success} {
+		if status, err := tick(nil); err != nil || status != expected {
+			t.Fatal(i, status, err)
+		}
+		if (ctx.Err() != nil) != (expected != Running) {
+			t.Error(i, ctx.Err())
+		}
+	}
+	ctx = nil
+	if status, err := tick(nil); err != e || status != Running {
+		t.Fatal(status, err)
+	}
+	if ctx.Err() == nil {
+		t.Error(`expected canceled`)
+	}
+}
+
+func TestTimeout_deadline(t *testing.T) {
+	for _, tc := range []struct {
+		Name    string
+		Options []TimeoutOption
+		Err     error
+	}{
+		{Name: `failure`},
+		{Name: `error`, Options: []TimeoutOption{WithTimeoutError(ErrTimeout)}, Err: ErrTimeout},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			var (
+				count    int
+				halted   int
+				btCtx    = new(Context)
+				duration = time.Millisecond * 30
+				tick     = Timeout(duration, btCtx.Tick(func(ctx context.Context, children []Node) (Status, error) {
+					count++
+					if ctx.Err() != nil {
+						t.Error(ctx.Err())
+					}
+					return Running, nil
+				}), append(tc.Options, WithTimeoutContext(btCtx))...)
+				children = []Node{New(nil).WithHalt(func() { halted++ })}
+			)
+			for x := 0; x < 2; x++ {
+				start := time.Now()
+				for time.Since(start) < duration/2 {
+					if status, err := tick(children); err != nil || status != Running {
+						t.Fatal(status, err)
+					}
+				}
+				if halted != x {
+					t.Error(halted)
+				}
+				time.Sleep(duration)
+				n := count
+				if status, err := tick(children); err != tc.Err || status != Failure {
+					t.Fatal(status, err)
+				}
+				if count != n {
+					t.Error(`unexpected tick`)
+				}
+				if halted != x+1 {
+					t.Error(halted)
+				}
+				if btCtx.ctx.Err() == nil {
+					t.Error(`expected canceled`)
+				}
+			}
+		})
+	}
+}
+
+func TestTimeout_noContext(t *testing.T) {
+	tick := Timeout(0, func(children []Node) (Status, error) { return Running, nil })
+	if status, err := tick(nil); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if status, err := tick(nil); err != nil || status != Failure {
+		t.Fatal(status, err)
+	}
+}
+
+func TestTimeout_halt(t *testing.T) {
+	var (
+		halted   int
+		btCtx    = new(Context)
+		duration = time.Millisecond * 20
+		node     = New(
+			Timeout(duration, btCtx.Tick(func(ctx context.Context, children []Node) (Status, error) {
+				if ctx.Err() != nil {
+					t.Error(ctx.Err())
+				}
+				return Running, nil
+			}), WithTimeoutContext(btCtx), WithTimeoutError(ErrTimeout)),
+			New(nil).WithHalt(func() { halted++ }),
+		)
+	)
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	ctx := btCtx.ctx
+	time.Sleep(duration * 2)
+	node.Halt()
+	if halted != 1 {
+		t.Error(halted)
+	}
+	if ctx.Err() == nil {
+		t.Error(`expected canceled`)
+	}
+	// re-entering starts a new execution, rather than timing out
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	if btCtx.ctx == ctx || btCtx.ctx.Err() != nil {
+		t.Error(`expected new context`)
+	}
+}
+
+func TestTimeout_haltDelegates(t *testing.T) {
+	var halted int
+	node := New(
+		Timeout(0, Tick(func(children []Node) (Status, error) { return Running, nil }).WithHalt(func([]Node) { halted++ })),
+		New(nil).WithHalt(func() { t.Error(`unexpected halt`) }),
+	)
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	// times out, halting via the wrapped tick
+	if status, err := node.Tick(); err != nil || status != Failure {
+		t.Fatal(status, err)
+	}
+	if halted != 1 {
+		t.Error(halted)
+	}
+	node.Halt()
+	if halted != 2 {
+		t.Error(halted)
+	}
+}
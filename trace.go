@@ -0,0 +1,155 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type (
+	// Tracer observes the execution of a tree, see Trace
+	Tracer interface {
+		// OnTickStart is called prior to ticking node, which is at the given path of child indexes from the root
+		// (which is empty), with the context returned for the nearest ancestor that has been ticked (or
+		// context.Background), returning the context for the tick, to be passed to OnTickEnd, and to any descendants.
+		// The path must not be modified.
+		OnTickStart(ctx context.Context, node Node, path []int) context.Context
+		// OnTickEnd is called after ticking node, with the context returned by the corresponding OnTickStart, the
+		// result, and the time it took
+		OnTickEnd(ctx context.Context, node Node, status Status, err error, elapsed time.Duration)
+	}
+
+	// TraceOption configures the behavior of Trace, see also WithClock
+	TraceOption interface {
+		applyTrace(c *traceConfig)
+	}
+
+	traceConfig struct {
+		clock Clock
+	}
+
+	// wrappedNode wraps a node and (recursively, on expansion) it's children, wrapping each tick, see Trace and
+	// AnnotateErrors
+	wrappedNode struct {
+		node   Node
+		parent *wrappedNode
+		// path is the index of each node (excluding the root) within the children of it's parent
+		path []int
+		// key identifies path, see wrappedTree.contexts
+		key  string
+		tree *wrappedTree
+	}
+
+	// wrappedTree is the state shared by each wrappedNode, of a single call to wrapNode
+	wrappedTree struct {
+		wrap  func(x *wrappedNode, tick Tick) Tick
+		mutex sync.Mutex
+		// contexts are the context of the last tick of each path, by key, see Trace, noting that they are keyed by
+		// path, rather than stored on the wrappedNode, as stateful ticks (e.g. Fork) may retain children, and
+		// therefore the parent, from a previous expansion
+		contexts map[string]context.Context
+	}
+)
+
+// Trace returns node wrapped such that each tick, of it or any descendant, will notify tracer, implementing
+// tree-aware debug tracing. Nodes are wrapped recursively, on expansion, in the same manner as Memorize, and attached
+// values (e.g. Node.Name) are preserved, though the node provided to the tracer will be the original (not wrapped)
+// node, which should be preferred for identification, e.g. via GetName and Node.Frame. The tracer will be called
+// synchronously, from within each tick, and each OnTickEnd is correlated with it's OnTickStart by the context, which
+// is also passed down to descendants, even if they are ticked concurrently, e.g. by Fork. Halting (see Node.Halt) is
+// passed through, to the wrapped ticks. Nil will be returned if node is nil, and a panic will occur if tracer is nil.
+func Trace(node Node, tracer Tracer, options ...TraceOption) Node {
+	if tracer == nil {
+		panic(errors.New(`behaviortree.Trace nil tracer`))
+	}
+	if node == nil {
+		return nil
+	}
+	var c traceConfig
+	for _, o := range options {
+		o.applyTrace(&c)
+	}
+	clock := orDefaultClock(c.clock)
+	return wrapNode(node, func(x *wrappedNode, tick Tick) Tick {
+		return func(children []Node) (Status, error) {
+			ctx := tracer.OnTickStart(x.parent.context(), x.node, x.path)
+			x.setContext(ctx)
+			start := clock.Now()
+			status, err := tick(children)
+			tracer.OnTickEnd(ctx, x.node, status, err, clock.Now().Sub(start))
+			return status, err
+		}
+	})
+}
+
+// wrapNode returns node wrapped, recursively, on expansion, using wrap, which is called for each non-nil tick
+func wrapNode(node Node, wrap func(x *wrappedNode, tick Tick) Tick) Node {
+	return (&wrappedNode{node: node, path: []int{}, tree: &wrappedTree{wrap: wrap}}).expand
+}
+
+func (x *wrappedNode) expand() (Tick, []Node) {
+	tick, children := x.node()
+	if len(children) != 0 {
+		nodes := make([]Node, len(children))
+		for i, child := range children {
+			if child != nil {
+				nodes[i] = (&wrappedNode{
+					node:   child,
+					parent: x,
+					path:   append(x.path[:len(x.path):len(x.path)], i),
+					key:    x.key + `/` + strconv.Itoa(i),
+					tree:   x.tree,
+				}).expand
+			}
+		}
+		children = nodes
+	}
+	if tick == nil {
+		return nil, children
+	}
+	return decorate(tick, x.tree.wrap(x, tick)), children
+}
+
+// setContext records ctx as the context of the last tick of the receiver's path
+func (x *wrappedNode) setContext(ctx context.Context) {
+	x.tree.mutex.Lock()
+	defer x.tree.mutex.Unlock()
+	if x.tree.contexts == nil {
+		x.tree.contexts = make(map[string]context.Context)
+	}
+	x.tree.contexts[x.key] = ctx
+}
+
+// context returns the context of the last tick of the receiver's path, or that of it's nearest ancestor with one, or
+// context.Background
+func (x *wrappedNode) context() context.Context {
+	if x == nil {
+		return context.Background()
+	}
+	x.tree.mutex.Lock()
+	defer x.tree.mutex.Unlock()
+	for ; x != nil; x = x.parent {
+		if ctx, ok := x.tree.contexts[x.key]; ok {
+			return ctx
+		}
+	}
+	return context.Background()
+}
@@ -0,0 +1,53 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	bt "github.com/joeycumines/go-behaviortree"
+	"github.com/joeycumines/go-behaviortree/bttest"
+)
+
+type elapsedTracer []time.Duration
+
+func (x *elapsedTracer) OnTickStart(ctx context.Context, _ bt.Node, _ []int) context.Context {
+	return ctx
+}
+
+func (x *elapsedTracer) OnTickEnd(_ context.Context, _ bt.Node, _ bt.Status, _ error, elapsed time.Duration) {
+	*x = append(*x, elapsed)
+}
+
+func TestTrace_clock(t *testing.T) {
+	var (
+		c      = bttest.NewClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		tracer elapsedTracer
+	)
+	node := bt.Trace(bt.New(bt.Sequence, bt.New(func(children []bt.Node) (bt.Status, error) {
+		c.Advance(time.Second)
+		return bt.Success, nil
+	})), &tracer, bt.WithClock(c))
+	if status, err := node.Tick(); err != nil || status != bt.Success {
+		t.Fatal(status, err)
+	}
+	if len(tracer) != 2 || tracer[0] != time.Second || tracer[1] != time.Second {
+		t.Error(tracer)
+	}
+}
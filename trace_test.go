@@ -0,0 +1,239 @@
+/*
+   Copyright 2026 Joseph Cumines
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package behaviortree
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+type (
+	traceRecorder struct {
+		mutex  sync.Mutex
+		events []string
+	}
+
+	// traceTickRecorder records the (root) tick number, of the context passed to each OnTickStart
+	traceTickRecorder struct {
+		ticks  int
+		events []string
+	}
+
+	// vkTraceParent is the context key for the name of the node, see traceRecorder
+	vkTraceParent struct{}
+
+	// vkTraceTick is the context key for the tick number, see traceTickRecorder
+	vkTraceTick struct{}
+)
+
+func (r *traceTickRecorder) OnTickStart(ctx context.Context, node Node, path []int) context.Context {
+	if len(path) == 0 {
+		r.ticks++
+		ctx = context.WithValue(ctx, vkTraceTick{}, r.ticks)
+	}
+	r.events = append(r.events, fmt.Sprintf(`%s %v`, node.Name(), ctx.Value(vkTraceTick{})))
+	return context.WithValue(ctx, vkTraceParent{}, node.Name())
+}
+
+func (r *traceTickRecorder) OnTickEnd(context.Context, Node, Status, error, time.Duration) {}
+
+func (r *traceRecorder) OnTickStart(ctx context.Context, node Node, path []int) context.Context {
+	parent, _ := ctx.Value(vkTraceParent{}).(string)
+	r.record(fmt.Sprintf(`start %s %v %s`, node.Name(), path, parent))
+	return context.WithValue(ctx, vkTraceParent{}, node.Name())
+}
+
+func (r *traceRecorder) OnTickEnd(ctx context.Context, node Node, status Status, err error, elapsed time.Duration) {
+	if v := ctx.Value(vkTraceParent{}); v != node.Name() {
+		r.record(fmt.Sprintf(`unexpected context %v`, v))
+	}
+	r.record(fmt.Sprintf(`end %s %s %v`, node.Name(), status, err))
+}
+
+func (r *traceRecorder) record(event string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.events = append(r.events, event)
+}
+
+func TestTrace(t *testing.T) {
+	var (
+		r        traceRecorder
+		expected = errors.New(`some_error`)
+		leaf     = func(name string, status Status, err error) Node {
+			return New(func([]Node) (Status, error) { return status, err }).WithName(name)
+		}
+		node = Trace(New(
+			Selector,
+			New(
+				Sequence,
+				leaf(`a`, Success, nil),
+				leaf(`b`, Failure, nil),
+			).WithName(`seq`),
+			leaf(`c`, Failure, expected),
+		).WithName(`root`), &r)
+	)
+	if v := node.Name(); v != `root` {
+		t.Error(v)
+	}
+	if status, err := node.Tick(); err != expected || status != Failure {
+		t.Fatal(status, err)
+	}
+	if v := r.events; !reflect.DeepEqual(v, []string{
+		`start root [] `,
+		`start seq [0] root`,
+		`start a [0 0] seq`,
+		`end a success <nil>`,
+		`start b [0 1] seq`,
+		`end b failure <nil>`,
+		`end seq failure <nil>`,
+		`start c [1] root`,
+		`end c failure some_error`,
+		`end root failure some_error`,
+	}) {
+		t.Errorf("%q", v)
+	}
+	if _, children := Trace(New(Sequence, nil), &r)(); len(children) != 1 || children[0] != nil {
+		t.Error(children)
+	}
+}
+
+func TestTrace_fork(t *testing.T) {
+	var (
+		r     traceRecorder
+		ready sync.WaitGroup
+		leaf  = func(name string) Node {
+			return New(func([]Node) (Status, error) {
+				// ensure both children are ticking concurrently
+				ready.Done()
+				ready.Wait()
+				return Success, nil
+			}).WithName(name)
+		}
+		node = Trace(New(
+			Sequence,
+			New(Fork(), leaf(`a`), leaf(`b`)).WithName(`fork`),
+		).WithName(`root`), &r)
+	)
+	ready.Add(2)
+	if status, err := node.Tick(); err != nil || status != Success {
+		t.Fatal(status, err)
+	}
+	sort.Strings(r.events)
+	if v := r.events; !reflect.DeepEqual(v, []string{
+		`end a success <nil>`,
+		`end b success <nil>`,
+		`end fork success <nil>`,
+		`end root success <nil>`,
+		`start a [0 0] fork`,
+		`start b [0 1] fork`,
+		`start fork [0] root`,
+		`start root [] `,
+	}) {
+		t.Errorf("%q", v)
+	}
+}
+
+func TestTrace_retainedChildren(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		tick func() Tick
+	}{
+		{`fork`, Fork},
+		{`memorize`, func() Tick { return Memorize(Sequence) }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				r     traceTickRecorder
+				count int
+				leaf  = New(func([]Node) (Status, error) {
+					count++
+					if count < 3 {
+						return Running, nil
+					}
+					return Success, nil
+				}).WithName(`leaf`)
+				node = Trace(New(
+					Sequence,
+					New(tc.tick(), leaf).WithName(`parent`),
+				).WithName(`root`), &r)
+			)
+			for i := 0; i < 3; i++ {
+				if _, err := node.Tick(); err != nil {
+					t.Fatal(err)
+				}
+			}
+			// the (retained) leaf must be passed the context of the current tick
+			if v := r.events; !reflect.DeepEqual(v, []string{
+				`root 1`, `parent 1`, `leaf 1`,
+				`root 2`, `parent 2`, `leaf 2`,
+				`root 3`, `parent 3`, `leaf 3`,
+			}) {
+				t.Errorf("%q", v)
+			}
+		})
+	}
+}
+
+func TestTrace_halt(t *testing.T) {
+	hr := &haltRecorder{statuses: map[string]Status{`a`: Success, `b`: Running}}
+	node := Trace(New(ReactiveSequence(), hr.node(`a`), hr.node(`b`)), new(traceRecorder))
+	if status, err := node.Tick(); err != nil || status != Running {
+		t.Fatal(status, err)
+	}
+	hr.take()
+	node.Halt()
+	if v := hr.take(); !reflect.DeepEqual(v, []string{`halt b`}) {
+		t.Error(v)
+	}
+}
+
+func TestTrace_nilNode(t *testing.T) {
+	if v := Trace(nil, new(traceRecorder)); v != nil {
+		t.Error(`expected nil`)
+	}
+}
+
+func TestTrace_nilTick(t *testing.T) {
+	var r traceRecorder
+	node := Trace(func() (Tick, []Node) { return nil, []Node{New(Sequence)} }, &r)
+	if status, err := node.Tick(); err == nil || status != Failure {
+		t.Error(status, err)
+	}
+	if tick, children := node(); tick != nil || len(children) != 1 {
+		t.Error(tick, children)
+	}
+	if r.events != nil {
+		t.Error(r.events)
+	}
+}
+
+func TestTrace_nilTracer(t *testing.T) {
+	defer func() {
+		if s := fmt.Sprint(recover()); s != `behaviortree.Trace nil tracer` {
+			t.Error(s)
+		}
+	}()
+	Trace(New(Sequence), nil)
+	t.Error(`expected a panic`)
+}
@@ -20,7 +20,6 @@ import (
 	"errors"
 	"reflect"
 	"runtime"
-	"sync"
 	"sync/atomic"
 )
 
@@ -30,20 +29,42 @@ var (
 	runtimeFuncForPC     = runtime.FuncForPC
 )
 
+// valueSlotCount is the maximum number of concurrent lookups requiring expansion, see Node.valueSync
+const valueSlotCount = 8
+
 var (
-	valueCallMutex  sync.Mutex
-	valueDataMutex  sync.RWMutex
-	valueDataKey    any
-	valueDataChan   chan any
-	valueDataCaller [1]uintptr
-	valueActive     uint32
+	// valueSlots are the in-progress lookups, indexed by slot
+	valueSlots [valueSlotCount]atomic.Pointer[valueLookup]
+	// valueSlotFree contains the indexes of the free slots
+	valueSlotFree = func() chan int {
+		c := make(chan int, valueSlotCount)
+		for i := 0; i < valueSlotCount; i++ {
+			c <- i
+		}
+		return c
+	}()
+	// valueActive is the number of in-progress lookups, allowing UseValueProvider to exit early
+	valueActive uint32
+	// valueTrampolines are distinct functions, one per slot, identifying the lookup (via the call stack)
+	valueTrampolines = [valueSlotCount]func(n Node, l *valueLookup) bool{
+		valueTrampoline0, valueTrampoline1, valueTrampoline2, valueTrampoline3,
+		valueTrampoline4, valueTrampoline5, valueTrampoline6, valueTrampoline7,
+	}
 )
 
+// valueLookup models an in-progress lookup, requiring expansion, see Node.valueSync
+type valueLookup struct {
+	key    any
+	ch     chan any
+	caller [1]uintptr
+}
+
 // WithValue will return the receiver wrapped with a key-value pair, using similar semantics to the context package.
 //
 // Values should only be used to attach information to BTs in a way that transits API boundaries, not for passing
-// optional parameters to functions. Some package-level synchronisation was necessary to facilitate this mechanism. As
-// such, this and the Node.Value method should be used with caution, preferably only outside normal operation.
+// optional parameters to functions. Some package-level synchronisation is necessary to resolve values of custom nodes
+// (see UseValueProvider). As such, this and the Node.Value method should be used with caution, preferably only
+// outside normal operation.
 //
 // The same restrictions on the key apply as for context.WithValue.
 func (n Node) WithValue(key, value any) Node {
@@ -56,60 +77,113 @@ func (n Node) WithValue(key, value any) Node {
 	if !reflect.TypeOf(key).Comparable() {
 		panic(errors.New(`behaviortree.Node.WithValue key is not comparable`))
 	}
-	return func() (Tick, []Node) {
-		UseValueHandler(func(k any) (any, bool) {
-			if k == key {
-				return value, true
-			}
-			return nil, false
-		})
-		return n()
+	return (&nodeDescriptor{
+		provider: keyValueProvider{key: key, value: value},
+		next:     n,
+	}).Node()
+}
+
+// WithValueProvider will return the receiver wrapped with the provider, which will be consulted (prior to any values
+// of the receiver) by Node.Value, in constant time, and is an efficient alternative to registering the provider using
+// UseValueProvider, e.g. for nodes with many values. A nil provider will return the receiver unchanged.
+func (n Node) WithValueProvider(provider ValueProvider) Node {
+	if n == nil {
+		panic(errors.New(`behaviortree.Node.WithValueProvider nil receiver`))
+	}
+	if provider == nil {
+		return n
 	}
+	return (&nodeDescriptor{
+		provider: provider,
+		next:     n,
+	}).Node()
 }
 
 // Value will return the value associated with this node for key, or nil if there is none.
 //
+// Values attached using Node.WithValue, or by nodes constructed using New or NewNode, are resolved directly, in
+// constant time, without locking, and may be resolved concurrently. Only if the node (or a node it wraps) is a custom
+// implementation will it be expanded, using the slower mechanism backing UseValueProvider, which supports a limited
+// number of concurrent lookups, and will block if they are exhausted.
+//
 // See also Node.WithValue, as well as the value mechanism provided by the context package.
 func (n Node) Value(key any) any {
+	value, n := descriptorValue(n, key)
 	if n != nil {
-		valueCallMutex.Lock()
-		defer valueCallMutex.Unlock()
 		return n.valueSync(key)
 	}
-	return nil
+	return value
 }
 
-// valueSync is split out into it's own method and the Node.valuePrep call is used as a discriminator for relevant
-// values
+// valueSync resolves the value by expanding the node, using a free slot, each of which has a distinct trampoline,
+// which is used by UseValueProvider to identify the relevant lookup
 func (n Node) valueSync(key any) (value any) {
-	if n.valuePrep(key) {
+	i := <-valueSlotFree
+	defer func() { valueSlotFree <- i }()
+	l := valueLookup{key: key, ch: make(chan any, 1)}
+	if valueTrampolines[i](n, &l) {
 		select {
-		case value = <-valueDataChan:
+		case value = <-l.ch:
 		default:
 		}
-		valueDataMutex.Lock()
-		valueDataKey = nil
-		valueDataChan = nil
-		valueDataMutex.Unlock()
 	}
 	return
 }
 
-func (n Node) valuePrep(key any) bool {
-	valueDataMutex.Lock()
-	if runtimeCallers(2, valueDataCaller[:]) < 1 {
-		valueDataMutex.Unlock()
+// valueCall records the caller (the trampoline) then expands the node, with the lookup active in the given slot
+//
+//go:noinline
+func valueCall(slot int, n Node, l *valueLookup) bool {
+	if runtimeCallers(2, l.caller[:]) < 1 {
 		return false
 	}
-	valueDataKey = key
-	valueDataChan = make(chan any, 1)
-	atomic.StoreUint32(&valueActive, 1)
-	valueDataMutex.Unlock()
-	defer atomic.StoreUint32(&valueActive, 0)
+	valueSlots[slot].Store(l)
+	atomic.AddUint32(&valueActive, 1)
+	defer func() {
+		atomic.AddUint32(&valueActive, ^uint32(0))
+		valueSlots[slot].Store(nil)
+	}()
 	n()
 	return true
 }
 
+//go:noinline
+func valueTrampoline0(n Node, l *valueLookup) bool { return valueCall(0, n, l) }
+
+//go:noinline
+func valueTrampoline1(n Node, l *valueLookup) bool { return valueCall(1, n, l) }
+
+//go:noinline
+func valueTrampoline2(n Node, l *valueLookup) bool { return valueCall(2, n, l) }
+
+//go:noinline
+func valueTrampoline3(n Node, l *valueLookup) bool { return valueCall(3, n, l) }
+
+//go:noinline
+func valueTrampoline4(n Node, l *valueLookup) bool { return valueCall(4, n, l) }
+
+//go:noinline
+func valueTrampoline5(n Node, l *valueLookup) bool { return valueCall(5, n, l) }
+
+//go:noinline
+func valueTrampoline6(n Node, l *valueLookup) bool { return valueCall(6, n, l) }
+
+//go:noinline
+func valueTrampoline7(n Node, l *valueLookup) bool { return valueCall(7, n, l) }
+
+// keyValueProvider implements ValueProvider for a single key-value pair, see Node.WithValue
+type keyValueProvider struct {
+	key   any
+	value any
+}
+
+func (p keyValueProvider) Value(key any) (any, bool) {
+	if key == p.key {
+		return p.value, true
+	}
+	return nil, false
+}
+
 // ValueProvider defines a mechanism to provide values for specific keys.
 type ValueProvider interface {
 	// Value returns the value associated with the key, or (nil, false) if not found.
@@ -152,14 +226,20 @@ func UseValueProviders(providers ...ValueProvider) {
 // UseValueProvider may be called when expanding _any_ [Node], and allows the provided
 // provider to respond to [Node.Value] queries for that node. If there are multiple,
 // called during node expansion, the outermost one that responds with true will take precedence.
+// If lookups are nested, i.e. a node calls [Node.Value] during its own expansion, the provider
+// will respond only to the innermost lookup.
 // In the absence of an in-progress [Node.Value] call, the cost of this function is trivial.
-// See also [Node.WithValue], which is implemented using this mechanism.
+// See also [Node.WithValue], which attaches values that are resolved directly, without expansion.
 //
 // Use cases:
 //   - Attaching metadata to custom nodes (e.g., frame/caller information)
 //   - Efficiently handling large numbers of key-value pairs without wrapping nodes repeatedly
 //   - Debugging or inspection of custom node implementations during development
 //
+// Note that resolving values registered this way requires expanding the node, and scanning the call stack, and the
+// number of such lookups that may proceed concurrently is limited. Where possible, prefer Node.WithValueProvider,
+// which is resolved in constant time, without locking.
+//
 // The provider receives a key and should return (value, true) if it handles that key,
 // or (nil, false) otherwise. Only providers registered on nodes appearing in the call stack
 // from the [Node.Value] call will be considered.
@@ -179,46 +259,64 @@ func UseValueProviders(providers ...ValueProvider) {
 //		}
 //	}
 func UseValueProvider(provider ValueProvider) {
+	// fast exit case 1: there are no pending value operations
 	if atomic.LoadUint32(&valueActive) == 0 {
 		return
 	}
-	valueDataMutex.RLock()
-	dataKey, dataChan, dataCaller := valueDataKey, valueDataChan, valueDataCaller
-	valueDataMutex.RUnlock()
 
-	// fast exit case 1: there is no pending value operation
-	if dataChan == nil {
-		return
+	// slow case, may require walking the entire call stack, once, if any lookups are relevant
+	var (
+		lookups  [valueSlotCount]*valueLookup
+		values   [valueSlotCount]any
+		relevant [valueSlotCount]bool
+		found    bool
+	)
+	for i := range valueSlots {
+		l := valueSlots[i].Load()
+		if l == nil {
+			continue
+		}
+		lookups[i] = l
+		values[i], relevant[i] = provider.Value(l.key)
+		found = found || relevant[i]
 	}
 
-	// fast exit case 2: pending value operation is not relevant
-	value, ok := provider.Value(dataKey)
-	if !ok {
+	// fast exit case 2: pending value operations are not relevant
+	if !found {
 		return
 	}
-	dataKey = nil
 
-	// slow case, may require walking the entire call stack
-	const depth = 2 << 7
-	callers := make([]uintptr, depth)
-	skip := 2
-	for {
-		n := runtimeCallers(skip, callers)
-		if n == 0 {
-			break
-		}
-		for _, pc := range callers[:n] {
-			if pc == dataCaller[0] {
+	// the provider belongs to the innermost lookup on the call stack, i.e. the one with the first trampoline, noting
+	// that lookups may be nested, e.g. if a custom node calls Node.Value (of another node) during expansion
+	callers, n := valueCallers()
+	for _, pc := range callers[:n] {
+		for i, l := range lookups {
+			if l == nil || pc != l.caller[0] {
+				continue
+			}
+			if relevant[i] {
 				select {
-				case dataChan <- value:
+				case l.ch <- values[i]:
 				default:
 				}
-				return
 			}
+			return
 		}
+	}
+}
+
+// valueCallers returns the call stack of the caller of UseValueProvider
+func valueCallers() ([]uintptr, int) {
+	const depth = 2 << 7
+	callers := make([]uintptr, depth)
+	var n int
+	for skip := 3; ; {
+		m := runtimeCallers(skip, callers[n:])
+		n += m
 		if n < len(callers) {
-			break
+			return callers, n
 		}
-		skip += n
+		skip += m
+		callers = append(callers, make([]uintptr, depth)...)
 	}
 }
@@ -21,3 +21,46 @@ func BenchmarkNode_Tick_Wrapped_NoValue(b *testing.B) {
 		wrapped.Tick()
 	}
 }
+
+func BenchmarkNode_Value_Descriptor(b *testing.B) {
+	node := NewNode(func(children []Node) (Status, error) { return Success, nil }, nil).WithName("bench")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Result = node.Value(vkName{})
+	}
+}
+
+func BenchmarkNode_Value_Fallback(b *testing.B) {
+	inner := NewNode(func(children []Node) (Status, error) { return Success, nil }, nil).WithName("bench")
+	// a custom node, which must be expanded to resolve the value
+	node := Node(func() (Tick, []Node) { return inner() })
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Result = node.Value(vkName{})
+	}
+}
+
+func BenchmarkNode_Value_Descriptor_Parallel(b *testing.B) {
+	node := NewNode(func(children []Node) (Status, error) { return Success, nil }, nil).WithName("bench")
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if node.Value(vkName{}) == nil {
+				b.Error(`expected value`)
+			}
+		}
+	})
+}
+
+func BenchmarkNode_Value_Fallback_Parallel(b *testing.B) {
+	b.RunParallel(func(pb *testing.PB) {
+		// independent trees, per goroutine
+		inner := NewNode(func(children []Node) (Status, error) { return Success, nil }, nil).WithName("bench")
+		node := Node(func() (Tick, []Node) { return inner() })
+		for pb.Next() {
+			if node.Value(vkName{}) == nil {
+				b.Error(`expected value`)
+			}
+		}
+	})
+}
@@ -18,7 +18,6 @@ package behaviortree
 
 import (
 	"fmt"
-	"runtime"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -107,8 +106,10 @@ func TestNode_Value_simple(t *testing.T) {
 	if v := n2.Value(k1{}); v != `v1` {
 		t.Error(v)
 	}
-	if frame, _ := runtime.CallersFrames(valueDataCaller[:]).Next(); frame.Function != `github.com/joeycumines/go-behaviortree.Node.valueSync` {
-		t.Error(frame)
+	for i, fn := range valueTrampolines {
+		if frame := newFrame(fn); frame == nil || frame.Function != fmt.Sprintf(`github.com/joeycumines/go-behaviortree.valueTrampoline%d`, i) {
+			t.Error(i, frame)
+		}
 	}
 	if v := n2.Value(k2{}); v != nil {
 		t.Error(v)
@@ -155,10 +156,10 @@ func TestNode_Value_noCaller(t *testing.T) {
 	default:
 		t.Error(`expected done`)
 	}
-	// Ensure lock can still be acquired after Value call with nil key (no deadlock)
-	valueDataMutex.Lock()
-	//lint:ignore SA2001 testing lock availability
-	valueDataMutex.Unlock()
+	// Ensure the slot was released after Value call with nil key (no deadlock)
+	if n := len(valueSlotFree); n != valueSlotCount {
+		t.Error(n)
+	}
 }
 
 func TestNode_Value_nested(t *testing.T) {
@@ -519,3 +520,190 @@ func TestUseValueProvider_nilNode(t *testing.T) {
 		t.Log("expected nil for mismatched key (handler registered but key doesn't match)")
 	}
 }
+
+// blockingValueNode returns a custom node, with a value for key, which will signal started, then wait for release,
+// during lookups, i.e. when expanded with a lookup in progress
+func blockingValueNode(key, value any, started chan<- struct{}, release <-chan struct{}) Node {
+	return func() (Tick, []Node) {
+		UseValueHandler(func(k any) (any, bool) {
+			if k != key {
+				return nil, false
+			}
+			started <- struct{}{}
+			<-release
+			return value, true
+		})
+		return Sequence, nil
+	}
+}
+
+func TestNode_Value_concurrentFallback(t *testing.T) {
+	type k1 struct{}
+	type k2 struct{}
+	var (
+		started = make(chan struct{})
+		release = make(chan struct{})
+		results = make(chan any, 2)
+	)
+	for _, node := range []Node{
+		blockingValueNode(k1{}, 1, started, release),
+		blockingValueNode(k2{}, 2, started, release),
+	} {
+		go func() {
+			if node.Value(k1{}) != nil {
+				results <- node.Value(k1{})
+			} else {
+				results <- node.Value(k2{})
+			}
+		}()
+	}
+	// both lookups must be in progress at the same time
+	for i := 0; i < 2; i++ {
+		select {
+		case <-started:
+		case <-time.After(time.Second * 5):
+			t.Fatal(`expected concurrent lookups`)
+		}
+	}
+	close(release)
+	// the remaining lookups, of the value that was found, no longer block on release
+	go func() {
+		for range started {
+		}
+	}()
+	if a, b := <-results, <-results; a.(int)+b.(int) != 3 {
+		t.Error(a, b)
+	}
+	close(started)
+}
+
+func TestNode_Value_nestedLookup(t *testing.T) {
+	type k1 struct{}
+	type k2 struct{}
+	inner := Node(func() (Tick, []Node) {
+		UseValueHandler(func(key any) (any, bool) {
+			if key == (k2{}) {
+				return `inner`, true
+			}
+			return nil, false
+		})
+		return Sequence, nil
+	})
+	outer := Node(func() (Tick, []Node) {
+		UseValueHandler(func(key any) (any, bool) {
+			if key == (k1{}) {
+				// previously, this would deadlock
+				return inner.Value(k2{}), true
+			}
+			return nil, false
+		})
+		return Sequence, []Node{inner}
+	})
+	if v := outer.Value(k1{}); v != `inner` {
+		t.Error(v)
+	}
+	if v := outer.Value(k2{}); v != nil {
+		t.Error(v)
+	}
+	if n := len(valueSlotFree); n != valueSlotCount {
+		t.Error(n)
+	}
+}
+
+func TestNode_Value_nestedLookupSameKey(t *testing.T) {
+	type key struct{}
+	inner := Node(func() (Tick, []Node) {
+		UseValueHandler(func(any) (any, bool) { return `inner`, true })
+		return Sequence, nil
+	})
+	var values []any
+	outer := Node(func() (Tick, []Node) {
+		values = append(values, inner.Value(key{}))
+		UseValueHandler(func(any) (any, bool) { return `outer`, true })
+		return Sequence, []Node{inner}
+	})
+	// every pairing of slots, the lookups being nested
+	for i := 0; i < valueSlotCount*2; i++ {
+		values = values[:0]
+		if v := outer.Value(key{}); v != `outer` {
+			t.Fatal(i, v)
+		}
+		if len(values) != 1 || values[0] != `inner` {
+			t.Fatal(i, values)
+		}
+	}
+	if n := len(valueSlotFree); n != valueSlotCount {
+		t.Error(n)
+	}
+}
+
+func TestNode_Value_concurrentStress(t *testing.T) {
+	type key struct{ i int }
+	var wg sync.WaitGroup
+	for i := 0; i < valueSlotCount*4; i++ {
+		custom := Node(func() (Tick, []Node) {
+			UseValueHandler(func(k any) (any, bool) {
+				if k == (key{i}) {
+					return i, true
+				}
+				return nil, false
+			})
+			return Sequence, nil
+		})
+		nodes := []Node{custom, New(Sequence).WithValue(key{i}, i), custom.WithName(`name`)}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				node := nodes[j%len(nodes)]
+				if v := node.Value(key{i}); v != i {
+					t.Error(i, v)
+					return
+				}
+				if v := node.Value(key{i + 1}); v != nil {
+					t.Error(i, v)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if n := len(valueSlotFree); n != valueSlotCount {
+		t.Error(n)
+	}
+}
+
+func TestNode_Value_fork(t *testing.T) {
+	type key struct{}
+	children := make([]Node, valueSlotCount*2)
+	for i := range children {
+		var node Node
+		custom := Node(func() (Tick, []Node) {
+			UseValueHandler(func(k any) (any, bool) {
+				if k == (key{}) {
+					return i, true
+				}
+				return nil, false
+			})
+			return func(children []Node) (Status, error) {
+				// resolved while the other children are ticking, concurrently
+				if v := node.Value(key{}); v != i {
+					return Failure, fmt.Errorf(`child %d unexpected value %v`, i, v)
+				}
+				return Success, nil
+			}, nil
+		})
+		if i%2 == 0 {
+			node = custom
+		} else {
+			node = custom.WithName(`odd`)
+		}
+		children[i] = node
+	}
+	node := New(Fork(), children...)
+	for i := 0; i < 20; i++ {
+		if status, err := node.Tick(); err != nil || status != Success {
+			t.Fatal(status, err)
+		}
+	}
+}